@@ -2,6 +2,7 @@ package hit
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 
 	"golang.org/x/xerrors"
@@ -46,6 +47,186 @@ type IClear interface {
 	//         Expect("Hello World"),
 	//     )
 	Expect(value ...interface{}) IClearExpect
+
+	// Header removes all previous Send().Header() and Expect().Header() steps and all steps chained to Expect().Header()
+	// e.g. Expect().Header("Content-Type").Equal("application/json").
+	//
+	// If you specify an argument it will only remove the steps matching that header name.
+	//
+	// Usage:
+	//     Clear().Header()                // will remove all Send().Header() and Expect().Header() steps
+	//     Clear().Header("Authorization") // will remove all Send().Header("Authorization") and Expect().Header("Authorization") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Send().Header("Authorization", "Bearer token"),
+	//         Expect().Header("Authorization").Equal("Bearer token"),
+	//         Clear().Header("Authorization"),
+	//     )
+	Header(headerName ...string) IStep
+
+	// Method removes all previous Method() steps, this includes Connect(), Delete(), Get(), Head(), Post(), Options(),
+	// Put() and Trace().
+	//
+	// If you specify an argument it will only remove the steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Method()                                      // will remove all Method() steps
+	//     Clear().Method(http.MethodGet)                        // will remove all Get() steps
+	//     Clear().Method(http.MethodGet, "https://example.com") // will remove all Get("https://example.com") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Clear().Method(),
+	//         Post("https://example.com"),
+	//     )
+	Method(value ...interface{}) IStep
+
+	// BaseURL removes all previous BaseURL() steps.
+	//
+	// If you specify an argument it will only remove the BaseURL() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().BaseURL()                      // will remove all BaseURL() steps
+	//     Clear().BaseURL("https://example.com") // will remove all BaseURL("https://example.com") steps
+	//
+	// Example:
+	//     MustDo(
+	//         BaseURL("https://example.com"),
+	//         Clear().BaseURL(),
+	//         Get("https://example.org"),
+	//     )
+	BaseURL(value ...interface{}) IStep
+
+	// HTTPClient removes all previous HTTPClient() steps.
+	//
+	// If you specify an argument it will only remove the HTTPClient() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().HTTPClient()       // will remove all HTTPClient() steps
+	//     Clear().HTTPClient(client) // will remove all HTTPClient(client) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         HTTPClient(client),
+	//         Clear().HTTPClient(),
+	//     )
+	HTTPClient(client ...*http.Client) IStep
+
+	// Description removes all previous Description() steps.
+	//
+	// If you specify an argument it will only remove the Description() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Description()               // will remove all Description() steps
+	//     Clear().Description("Get example")  // will remove all Description("Get example") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Description("Get example"),
+	//         Clear().Description(),
+	//         Get("https://example.com"),
+	//     )
+	Description(description ...string) IStep
+
+	// Debug removes all previous Debug() steps.
+	//
+	// If you specify an argument it will only remove the Debug() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Debug()                   // will remove all Debug() steps
+	//     Clear().Debug("Response.Headers") // will remove all Debug("Response.Headers") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Debug(),
+	//         Clear().Debug(),
+	//     )
+	Debug(expression ...string) IStep
+
+	// Custom removes all previous Custom() steps that run during the specified step time.
+	//
+	// If you specify a function it will only remove the Custom() steps matching that function.
+	//
+	// Usage:
+	//     Clear().Custom(ExpectStep)     // will remove all Custom(ExpectStep, ...) steps
+	//     Clear().Custom(ExpectStep, fn) // will remove all Custom(ExpectStep, fn) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Custom(ExpectStep, func(hit Hit) {
+	//             if hit.Response().Body().String() != "Hello Earth" {
+	//                 panic("Expected Hello Earth")
+	//             }
+	//         }),
+	//         Clear().Custom(ExpectStep),
+	//     )
+	Custom(when StepTime, fn ...Callback) IStep
+
+	// OnBeforeSend removes all previous OnBeforeSend() hooks, use it to remove hooks of Defaults() or a suite.
+	//
+	// If you specify a function it will only remove the OnBeforeSend() hooks matching that function.
+	//
+	// Usage:
+	//     Clear().OnBeforeSend()   // will remove all OnBeforeSend() hooks
+	//     Clear().OnBeforeSend(fn) // will remove all OnBeforeSend(fn) hooks
+	//
+	// Example:
+	//     suite.Do(
+	//         Get("https://example.com"),
+	//         Clear().OnBeforeSend(),
+	//     )
+	OnBeforeSend(fn ...Callback) IStep
+
+	// OnResponse removes all previous OnResponse() hooks.
+	//
+	// If you specify a function it will only remove the OnResponse() hooks matching that function.
+	//
+	// Usage:
+	//     Clear().OnResponse()   // will remove all OnResponse() hooks
+	//     Clear().OnResponse(fn) // will remove all OnResponse(fn) hooks
+	//
+	// Example:
+	//     suite.Do(
+	//         Get("https://example.com"),
+	//         Clear().OnResponse(),
+	//     )
+	OnResponse(fn ...Callback) IStep
+
+	// OnFailure removes all previous OnFailure() hooks.
+	//
+	// If you specify a function it will only remove the OnFailure() hooks matching that function.
+	//
+	// Usage:
+	//     Clear().OnFailure()   // will remove all OnFailure() hooks
+	//     Clear().OnFailure(fn) // will remove all OnFailure(fn) hooks
+	//
+	// Example:
+	//     suite.Do(
+	//         Get("https://example.com"),
+	//         Clear().OnFailure(),
+	//     )
+	OnFailure(fn ...func(hit Hit, err error)) IStep
+
+	// OnStep removes all previous OnStep() hooks.
+	//
+	// If you specify a function it will only remove the OnStep() hooks matching that function.
+	//
+	// Usage:
+	//     Clear().OnStep()   // will remove all OnStep() hooks
+	//     Clear().OnStep(fn) // will remove all OnStep(fn) hooks
+	//
+	// Example:
+	//     suite.Do(
+	//         Get("https://example.com"),
+	//         Clear().OnStep(),
+	//     )
+	OnStep(fn ...func(hit Hit, step IStep, when StepTime, err error)) IStep
 }
 
 type clear struct{}
@@ -62,7 +243,120 @@ func (clr *clear) Expect(value ...interface{}) IClearExpect {
 	return newClearExpect(newClearPath("Expect", value), value)
 }
 
-func removeSteps(hit Hit, path clearPath) error {
+func (clr *clear) Header(headerName ...string) IStep {
+	args := make([]interface{}, len(headerName))
+	for i := range headerName {
+		args[i] = headerName[i]
+	}
+	return removeStep(
+		newClearPath("Send", nil).Push("Header", args),
+		newClearPath("Expect", nil).Push("Header", args),
+	)
+}
+
+func (clr *clear) Method(value ...interface{}) IStep {
+	paths := []clearPath{newClearPath("Method", value)}
+	// match the shortcuts (e.g. Get(url)) as well
+	for _, step := range methodSteps {
+		if len(value) == 0 {
+			paths = append(paths, newClearPath(step.name, nil))
+			continue
+		}
+		if method, ok := value[0].(string); ok && method == step.method {
+			paths = append(paths, newClearPath(step.name, value[1:]))
+		}
+	}
+	return removeStep(paths...)
+}
+
+func (clr *clear) BaseURL(value ...interface{}) IStep {
+	return removeStep(newClearPath("BaseURL", value))
+}
+
+func (clr *clear) HTTPClient(client ...*http.Client) IStep {
+	args := make([]interface{}, len(client))
+	for i := range client {
+		args[i] = client[i]
+	}
+	return removeStep(newClearPath("HTTPClient", args))
+}
+
+func (clr *clear) Description(description ...string) IStep {
+	args := make([]interface{}, len(description))
+	for i := range description {
+		args[i] = description[i]
+	}
+	return removeStep(newClearPath("Description", args))
+}
+
+func (clr *clear) Debug(expression ...string) IStep {
+	args := make([]interface{}, len(expression))
+	for i := range expression {
+		args[i] = expression[i]
+	}
+	return removeStep(newClearPath("Debug", args))
+}
+
+func (clr *clear) Custom(when StepTime, fn ...Callback) IStep {
+	args := make([]interface{}, 0, len(fn)+1)
+	args = append(args, when)
+	for i := range fn {
+		args = append(args, fn[i])
+	}
+	return removeStep(newClearPath("Custom", args))
+}
+
+func (clr *clear) OnBeforeSend(fn ...Callback) IStep {
+	args := make([]interface{}, len(fn))
+	for i := range fn {
+		args[i] = fn[i]
+	}
+	return removeStep(newClearPath("OnBeforeSend", args))
+}
+
+func (clr *clear) OnResponse(fn ...Callback) IStep {
+	args := make([]interface{}, len(fn))
+	for i := range fn {
+		args[i] = fn[i]
+	}
+	return removeStep(newClearPath("OnResponse", args))
+}
+
+func (clr *clear) OnFailure(fn ...func(hit Hit, err error)) IStep {
+	args := make([]interface{}, len(fn))
+	for i := range fn {
+		args[i] = fn[i]
+	}
+	return removeStep(newClearPath("OnFailure", args))
+}
+
+func (clr *clear) OnStep(fn ...func(hit Hit, step IStep, when StepTime, err error)) IStep {
+	args := make([]interface{}, len(fn))
+	for i := range fn {
+		args[i] = fn[i]
+	}
+	return removeStep(newClearPath("OnStep", args))
+}
+
+// isMethodPath reports whether the path belongs to a Method() step or to one of its shortcuts
+func isMethodPath(p clearPath) bool {
+	if len(p) != 1 {
+		return false
+	}
+	if p[0].Func == "Method" {
+		return true
+	}
+	for _, step := range methodSteps {
+		if p[0].Func == step.name {
+			return true
+		}
+	}
+	return false
+}
+
+// removeSteps removes all previous steps that match one of the paths, steps that were inserted by the removed steps
+// (e.g. the steps of CombineSteps()) are removed as well
+func removeSteps(hit Hit, paths ...clearPath) error {
 	var stepsToRemove []IStep
 	steps := hit.Steps()
 	availableSteps := make([]IStep, 0, len(steps))
@@ -75,14 +369,24 @@ func removeSteps(hit Hit, path clearPath) error {
 		if p == nil {
 			continue
 		}
-		availableSteps = append(availableSteps, step)
-		if p.Contains(path) {
-			stepsToRemove = append(stepsToRemove, step)
+		// every request has a Method() step, do not list them
+		if !isMethodPath(p) {
+			availableSteps = append(availableSteps, step)
+		}
+		for _, path := range paths {
+			if p.Contains(path) {
+				stepsToRemove = append(stepsToRemove, step)
+				break
+			}
 		}
 	}
 	if len(stepsToRemove) == 0 {
 		var sb strings.Builder
-		fmt.Fprintf(&sb, "unable to find a step with %s\n", path.CallString())
+		callStrings := make([]string, len(paths))
+		for i, path := range paths {
+			callStrings[i] = path.CallString()
+		}
+		fmt.Fprintf(&sb, "unable to find a step with %s\n", strings.Join(callStrings, " or "))
 
 		if len(availableSteps) > 0 {
 			fmt.Fprintf(&sb, "got these steps:\n")
@@ -93,17 +397,20 @@ func removeSteps(hit Hit, path clearPath) error {
 
 		return xerrors.New(sb.String())
 	}
+	if instance, ok := hit.(*defaultInstance); ok {
+		stepsToRemove = instance.withInsertedSteps(stepsToRemove)
+	}
 	hit.RemoveSteps(stepsToRemove...)
 	return nil
 }
 
-func removeStep(cleanPath clearPath) *hitStep {
+func removeStep(paths ...clearPath) *hitStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
 		When:      CleanStep,
 		ClearPath: nil, // not clearable
 		Exec: func(hit Hit) error {
-			return removeSteps(hit, cleanPath)
+			return removeSteps(hit, paths...)
 		},
 	}
 }
@@ -106,6 +106,147 @@ type IClearExpect interface {
 	//         Expect().Status(http.StatusOK),
 	//     )
 	Status(code ...int) IClearExpectStatus
+
+	// Trailer removes all previous Expect().Trailer() steps and all steps chained to Expect().Trailer()
+	// e.g. Expect().Trailer("Grpc-Status").Equal("0").
+	//
+	// If you specify an argument it will only remove the Expect().Trailer() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Trailer()                      // will remove all Expect().Trailer() steps and all chained steps to Expect().Trailer()
+	//     Clear().Expect().Trailer("Grpc-Status")         // will remove all Expect().Trailer("Grpc-Status") steps and all chained steps
+	//     Clear().Expect().Trailer("Grpc-Status").Equal() // will remove all Expect().Trailer("Grpc-Status").Equal() steps
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Trailer("Grpc-Status").Equal("0"),
+	//         Clear().Expect().Trailer(),
+	//         Expect().Trailer("Grpc-Status").Equal("5"),
+	//     )
+	Trailer(trailerName ...string) IClearExpectHeader
+
+	// Proto removes all previous Expect().Proto() steps and all steps chained to Expect().Proto()
+	// e.g. Expect().Proto().Equal("HTTP/2.0").
+	//
+	// Usage:
+	//     Clear().Expect().Proto() // will remove all Expect().Proto() steps and all chained steps to Expect().Proto()
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Proto().Equal("HTTP/2.0"),
+	//         Clear().Expect().Proto(),
+	//     )
+	Proto() IStep
+
+	// SSE removes all previous Expect().SSE() steps and all steps chained to Expect().SSE()
+	// e.g. Expect().SSE().Next().Data().Equal("Hello").
+	//
+	// Usage:
+	//     Clear().Expect().SSE() // will remove all Expect().SSE() steps and all chained steps to Expect().SSE()
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/events"),
+	//         Expect().SSE().Next().Data().Equal("Hello"),
+	//         Clear().Expect().SSE(),
+	//     )
+	SSE() IStep
+
+	// GraphQL removes all previous Expect().GraphQL() steps and all steps chained to Expect().GraphQL()
+	// e.g. Expect().GraphQL().NoErrors().
+	//
+	// Usage:
+	//     Clear().Expect().GraphQL() // will remove all Expect().GraphQL() steps and all chained steps to Expect().GraphQL()
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/graphql"),
+	//         Send().GraphQL("{ user { name } }", nil),
+	//         Expect().GraphQL().NoErrors(),
+	//         Clear().Expect().GraphQL(),
+	//     )
+	GraphQL() IStep
+
+	// Redirect removes all previous Expect().Redirect() steps and all steps chained to Expect().Redirect()
+	// e.g. Expect().Redirect().To("/login").
+	//
+	// Usage:
+	//     Clear().Expect().Redirect() // will remove all Expect().Redirect() steps and all chained steps to Expect().Redirect()
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/admin"),
+	//         FollowRedirects(false),
+	//         Expect().Redirect().To("/login"),
+	//         Clear().Expect().Redirect(),
+	//     )
+	Redirect() IStep
+
+	// Redirects removes all previous Expect().Redirects() steps and all steps chained to Expect().Redirects()
+	// e.g. Expect().Redirects().Len(1).
+	//
+	// Usage:
+	//     Clear().Expect().Redirects() // will remove all Expect().Redirects() steps and all chained steps to Expect().Redirects()
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/admin"),
+	//         Expect().Redirects().Len(1),
+	//         Clear().Expect().Redirects(),
+	//     )
+	Redirects() IStep
+
+	// TLS removes all previous Expect().TLS() steps and all steps chained to Expect().TLS()
+	// e.g. Expect().TLS().Version(tls.VersionTLS13).
+	//
+	// Usage:
+	//     Clear().Expect().TLS() // will remove all Expect().TLS() steps and all chained steps to Expect().TLS()
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().TLS().Version(tls.VersionTLS13),
+	//         Clear().Expect().TLS(),
+	//     )
+	TLS() IStep
+
+	// Message removes all previous Expect().Message() steps and all steps chained to Expect().Message()
+	// e.g. Expect().Message().Equal("Hello Joe").
+	//
+	// If you specify an argument it will only remove the Expect().Message() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Message()            // will remove all Expect().Message() steps and all chained steps to Expect().Message()
+	//     Clear().Expect().Message("Hello Joe") // will remove all Expect().Message("Hello Joe") steps
+	//
+	// Example:
+	//     MustDo(
+	//         WebSocket("ws://example.com/chat"),
+	//         Send().Message("Hello"),
+	//         Expect().Message("Hello Joe"),
+	//         Clear().Expect().Message(),
+	//     )
+	Message(value ...interface{}) IStep
+
+	// JSONRPC removes all previous Expect().JSONRPC() steps and all steps chained to Expect().JSONRPC()
+	// e.g. Expect().JSONRPC().NoError().
+	//
+	// If you specify an argument it will only remove the Expect().JSONRPC() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().JSONRPC()  // will remove all Expect().JSONRPC() steps and all chained steps to Expect().JSONRPC()
+	//     Clear().Expect().JSONRPC(1) // will remove all Expect().JSONRPC(1) steps and all chained steps to Expect().JSONRPC(1)
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/rpc"),
+	//         Send().JSONRPC("sum", []int{1, 2}),
+	//         Expect().JSONRPC().NoError(),
+	//         Clear().Expect().JSONRPC(),
+	//     )
+	JSONRPC(call ...int) IStep
 }
 
 type clearExpect struct {
@@ -175,6 +316,50 @@ func (exp *clearExpect) Status(code ...int) IClearExpectStatus {
 	return newClearExpectStatus(exp.clearPath().Push("Status", args), code)
 }
 
+func (exp *clearExpect) Trailer(trailerName ...string) IClearExpectHeader {
+	args := make([]interface{}, len(trailerName))
+	for i := range trailerName {
+		args[i] = trailerName[i]
+	}
+	return newClearExpectHeader(exp.clearPath().Push("Trailer", args))
+}
+
+func (exp *clearExpect) Proto() IStep {
+	return removeStep(exp.clearPath().Push("Proto", nil))
+}
+
+func (exp *clearExpect) SSE() IStep {
+	return removeStep(exp.clearPath().Push("SSE", nil))
+}
+
+func (exp *clearExpect) GraphQL() IStep {
+	return removeStep(exp.clearPath().Push("GraphQL", nil))
+}
+
+func (exp *clearExpect) Redirect() IStep {
+	return removeStep(exp.clearPath().Push("Redirect", nil))
+}
+
+func (exp *clearExpect) Redirects() IStep {
+	return removeStep(exp.clearPath().Push("Redirects", nil))
+}
+
+func (exp *clearExpect) TLS() IStep {
+	return removeStep(exp.clearPath().Push("TLS", nil))
+}
+
+func (exp *clearExpect) Message(value ...interface{}) IStep {
+	return removeStep(exp.clearPath().Push("Message", value))
+}
+
+func (exp *clearExpect) JSONRPC(call ...int) IStep {
+	args := make([]interface{}, len(call))
+	for i := range call {
+		args[i] = call[i]
+	}
+	return removeStep(exp.clearPath().Push("JSONRPC", args))
+}
+
 type finalClearExpect struct {
 	IStep
 	message string
@@ -219,3 +404,42 @@ func (exp *finalClearExpect) Status(...int) IClearExpectStatus {
 		exp.message,
 	}
 }
+
+func (exp *finalClearExpect) Trailer(...string) IClearExpectHeader {
+	return &finalClearExpectHeader{
+		exp.fail(),
+		exp.message,
+	}
+}
+
+func (exp *finalClearExpect) Proto() IStep {
+	return exp.fail()
+}
+
+func (exp *finalClearExpect) SSE() IStep {
+	return exp.fail()
+}
+
+func (exp *finalClearExpect) GraphQL() IStep {
+	return exp.fail()
+}
+
+func (exp *finalClearExpect) Redirect() IStep {
+	return exp.fail()
+}
+
+func (exp *finalClearExpect) Redirects() IStep {
+	return exp.fail()
+}
+
+func (exp *finalClearExpect) TLS() IStep {
+	return exp.fail()
+}
+
+func (exp *finalClearExpect) Message(...interface{}) IStep {
+	return exp.fail()
+}
+
+func (exp *finalClearExpect) JSONRPC(...int) IStep {
+	return exp.fail()
+}
@@ -47,6 +47,43 @@ type IClearExpectBody interface {
 	//     )
 	JSON(value ...interface{}) IClearExpectBodyJSON
 
+	// XML removes all previous Expect().Body().XML() steps and all steps chained to Expect().Body().XML()
+	// e.g. Expect().Body().XML().Equal("/user/name", "Joe").
+	//
+	// If you specify an argument it will only remove the Expect().Body().XML() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().XML()                           // will remove all Expect().Body().XML() steps and all chained steps to XML() e.g. Expect().Body().XML().Equal("/user/name", "Joe")
+	//     Clear().Expect().Body().XML(User{Name: "Joe"})          // will remove all Expect().Body().XML(User{Name: "Joe"}) steps
+	//     Clear().Expect().Body().XML().Equal()                   // will remove all Expect().Body().XML().Equal() steps
+	//     Clear().Expect().Body().XML().Equal("/user/name", "Joe") // will remove all Expect().Body().XML().Equal("/user/name", "Joe") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().XML().Equal("/user/name", "Joe"),
+	//         Clear().Expect().Body().XML(),
+	//         Expect().Body().XML().Equal("/user/name", "Alice"),
+	//     )
+	XML(value ...interface{}) IClearExpectBodyXML
+
+	// HTML removes all previous Expect().Body().HTML() steps and all steps chained to Expect().Body().HTML()
+	// e.g. Expect().Body().HTML().Select("h1").Text().Equal("Welcome").
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML()                                 // will remove all Expect().Body().HTML() steps and all chained steps to HTML()
+	//     Clear().Expect().Body().HTML().Select("h1")                    // will remove all Expect().Body().HTML().Select("h1") steps
+	//     Clear().Expect().Body().HTML().Select("h1").Text().Equal()     // will remove all Expect().Body().HTML().Select("h1").Text().Equal() steps
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().HTML().Select("h1").Text().Equal("Welcome"),
+	//         Clear().Expect().Body().HTML(),
+	//         Expect().Body().HTML().Select("h1").Text().Equal("Hello"),
+	//     )
+	HTML() IClearExpectBodyHTML
+
 	// Equal removes all previous Expect().Body().Equal() steps.
 	//
 	// If you specify an argument it will only remove the Expect().Body().Equal() steps matching that argument.
@@ -114,6 +151,40 @@ type IClearExpectBody interface {
 	//         Expect().Body().NotContains("Hello Earth"),
 	//     )
 	NotContains(value ...interface{}) IStep
+
+	// Matches removes all previous Expect().Body().Matches() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().Matches() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().Matches()              // will remove all Expect().Body().Matches() steps
+	//     Clear().Expect().Body().Matches(`Hello \w+`)   // will remove all Expect().Body().Matches(`Hello \w+`) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().Matches(`Hello \w+`),
+	//         Clear().Expect().Body().Matches(),
+	//         Expect().Body().Matches(`Hello World`),
+	//     )
+	Matches(value ...interface{}) IStep
+
+	// NotMatches removes all previous Expect().Body().NotMatches() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().NotMatches() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().NotMatches()             // will remove all Expect().Body().NotMatches() steps
+	//     Clear().Expect().Body().NotMatches(`(?i)error`)  // will remove all Expect().Body().NotMatches(`(?i)error`) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().NotMatches(`(?i)error`),
+	//         Clear().Expect().Body().NotMatches(),
+	//         Expect().Body().NotMatches(`(?i)failure`),
+	//     )
+	NotMatches(value ...interface{}) IStep
 }
 
 type clearExpectBody struct {
@@ -161,6 +232,14 @@ func (body *clearExpectBody) JSON(value ...interface{}) IClearExpectBodyJSON {
 	return newClearExpectBodyJSON(body, body.clearPath().Push("JSON", value), value)
 }
 
+func (body *clearExpectBody) XML(value ...interface{}) IClearExpectBodyXML {
+	return newClearExpectBodyXML(body, body.clearPath().Push("XML", value), value)
+}
+
+func (body *clearExpectBody) HTML() IClearExpectBodyHTML {
+	return newClearExpectBodyHTML(body.clearPath().Push("HTML", nil))
+}
+
 func (body *clearExpectBody) Equal(value ...interface{}) IStep {
 	return removeStep(body.clearPath().Push("Equal", value))
 }
@@ -177,6 +256,14 @@ func (body *clearExpectBody) NotContains(value ...interface{}) IStep {
 	return removeStep(body.clearPath().Push("NotContains", value))
 }
 
+func (body *clearExpectBody) Matches(value ...interface{}) IStep {
+	return removeStep(body.clearPath().Push("Matches", patternArguments(value)))
+}
+
+func (body *clearExpectBody) NotMatches(value ...interface{}) IStep {
+	return removeStep(body.clearPath().Push("NotMatches", patternArguments(value)))
+}
+
 type finalClearExpectBody struct {
 	IStep
 	message string
@@ -202,6 +289,18 @@ func (body *finalClearExpectBody) JSON(...interface{}) IClearExpectBodyJSON {
 		body.message,
 	}
 }
+func (body *finalClearExpectBody) XML(...interface{}) IClearExpectBodyXML {
+	return &finalClearExpectBodyXML{
+		body.fail(),
+		body.message,
+	}
+}
+func (body *finalClearExpectBody) HTML() IClearExpectBodyHTML {
+	return &finalClearExpectBodyHTML{
+		body.fail(),
+		body.message,
+	}
+}
 func (body *finalClearExpectBody) Equal(...interface{}) IStep {
 	return body.fail()
 }
@@ -214,3 +313,9 @@ func (body *finalClearExpectBody) Contains(...interface{}) IStep {
 func (body *finalClearExpectBody) NotContains(...interface{}) IStep {
 	return body.fail()
 }
+func (body *finalClearExpectBody) Matches(...interface{}) IStep {
+	return body.fail()
+}
+func (body *finalClearExpectBody) NotMatches(...interface{}) IStep {
+	return body.fail()
+}
@@ -0,0 +1,415 @@
+package hit
+
+import (
+	"github.com/Eun/go-hit/errortrace"
+	"golang.org/x/xerrors"
+)
+
+// IClearExpectBodyHTML provides a clear functionality to remove previous steps from running in the Expect().Body().HTML() scope
+type IClearExpectBodyHTML interface {
+	IStep
+	// Select removes all previous Expect().Body().HTML().Select() steps and all steps chained to
+	// Expect().Body().HTML().Select() e.g. Expect().Body().HTML().Select("h1").Text().Equal("Welcome").
+	//
+	// If you specify an argument it will only remove the Expect().Body().HTML().Select() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select()                      // will remove all Expect().Body().HTML().Select() steps
+	//     Clear().Expect().Body().HTML().Select("h1")                  // will remove all Expect().Body().HTML().Select("h1") steps
+	//     Clear().Expect().Body().HTML().Select("h1").Text().Equal()   // will remove all Expect().Body().HTML().Select("h1").Text().Equal() steps
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().HTML().Select("h1").Text().Equal("Welcome"),
+	//         Clear().Expect().Body().HTML().Select("h1"),
+	//         Expect().Body().HTML().Select("h1").Text().Equal("Hello"),
+	//     )
+	Select(value ...interface{}) IClearExpectBodyHTMLSelection
+}
+
+// IClearExpectBodyHTMLSelection provides a clear functionality to remove previous steps from running in the
+// Expect().Body().HTML().Select() scope
+type IClearExpectBodyHTMLSelection interface {
+	IStep
+	// Exists removes all previous Expect().Body().HTML().Select().Exists() steps.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Exists()          // will remove all Expect().Body().HTML().Select().Exists() steps
+	//     Clear().Expect().Body().HTML().Select("form").Exists()    // will remove all Expect().Body().HTML().Select("form").Exists() steps
+	Exists() IStep
+
+	// NotExists removes all previous Expect().Body().HTML().Select().NotExists() steps.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().NotExists()         // will remove all Expect().Body().HTML().Select().NotExists() steps
+	//     Clear().Expect().Body().HTML().Select(".error").NotExists() // will remove all Expect().Body().HTML().Select(".error").NotExists() steps
+	NotExists() IStep
+
+	// Count removes all previous Expect().Body().HTML().Select().Count() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().HTML().Select().Count() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Count()       // will remove all Expect().Body().HTML().Select().Count() steps
+	//     Clear().Expect().Body().HTML().Select("li").Count(3)  // will remove all Expect().Body().HTML().Select("li").Count(3) steps
+	Count(value ...interface{}) IStep
+
+	// Text removes all previous Expect().Body().HTML().Select().Text() steps and all steps chained to it.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Text()                     // will remove all Expect().Body().HTML().Select().Text() steps
+	//     Clear().Expect().Body().HTML().Select("h1").Text().Contains("Hi")  // will remove all Expect().Body().HTML().Select("h1").Text().Contains("Hi") steps
+	Text() IClearExpectBodyHTMLValue
+
+	// Attr removes all previous Expect().Body().HTML().Select().Attr() steps and all steps chained to it.
+	//
+	// If you specify an argument it will only remove the Expect().Body().HTML().Select().Attr() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Attr()                   // will remove all Expect().Body().HTML().Select().Attr() steps
+	//     Clear().Expect().Body().HTML().Select().Attr("value")            // will remove all Expect().Body().HTML().Select().Attr("value") steps
+	//     Clear().Expect().Body().HTML().Select().Attr("value").NotEmpty() // will remove all Expect().Body().HTML().Select().Attr("value").NotEmpty() steps
+	Attr(value ...interface{}) IClearExpectBodyHTMLValue
+}
+
+// IClearExpectBodyHTMLValue provides a clear functionality to remove previous steps from running in the
+// Expect().Body().HTML().Select().Text() and Expect().Body().HTML().Select().Attr() scope
+type IClearExpectBodyHTMLValue interface {
+	IStep
+	// Equal removes all previous Equal() steps.
+	//
+	// If you specify an argument it will only remove the Equal() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Text().Equal()           // will remove all Expect().Body().HTML().Select().Text().Equal() steps
+	//     Clear().Expect().Body().HTML().Select().Text().Equal("Welcome")  // will remove all Expect().Body().HTML().Select().Text().Equal("Welcome") steps
+	Equal(value ...interface{}) IStep
+
+	// NotEqual removes all previous NotEqual() steps.
+	//
+	// If you specify an argument it will only remove the NotEqual() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Text().NotEqual()          // will remove all Expect().Body().HTML().Select().Text().NotEqual() steps
+	//     Clear().Expect().Body().HTML().Select().Text().NotEqual("Error")   // will remove all Expect().Body().HTML().Select().Text().NotEqual("Error") steps
+	NotEqual(value ...interface{}) IStep
+
+	// Contains removes all previous Contains() steps.
+	//
+	// If you specify an argument it will only remove the Contains() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Text().Contains()          // will remove all Expect().Body().HTML().Select().Text().Contains() steps
+	//     Clear().Expect().Body().HTML().Select().Text().Contains("Welcome") // will remove all Expect().Body().HTML().Select().Text().Contains("Welcome") steps
+	Contains(value ...interface{}) IStep
+
+	// NotContains removes all previous NotContains() steps.
+	//
+	// If you specify an argument it will only remove the NotContains() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Text().NotContains()        // will remove all Expect().Body().HTML().Select().Text().NotContains() steps
+	//     Clear().Expect().Body().HTML().Select().Text().NotContains("Error") // will remove all Expect().Body().HTML().Select().Text().NotContains("Error") steps
+	NotContains(value ...interface{}) IStep
+
+	// OneOf removes all previous OneOf() steps.
+	//
+	// If you specify an argument it will only remove the OneOf() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Attr("lang").OneOf()           // will remove all Expect().Body().HTML().Select().Attr("lang").OneOf() steps
+	//     Clear().Expect().Body().HTML().Select().Attr("lang").OneOf("en", "de") // will remove all Expect().Body().HTML().Select().Attr("lang").OneOf("en", "de") steps
+	OneOf(value ...interface{}) IStep
+
+	// NotOneOf removes all previous NotOneOf() steps.
+	//
+	// If you specify an argument it will only remove the NotOneOf() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Attr("lang").NotOneOf()           // will remove all Expect().Body().HTML().Select().Attr("lang").NotOneOf() steps
+	//     Clear().Expect().Body().HTML().Select().Attr("lang").NotOneOf("fr", "es") // will remove all Expect().Body().HTML().Select().Attr("lang").NotOneOf("fr", "es") steps
+	NotOneOf(value ...interface{}) IStep
+
+	// Empty removes all previous Empty() steps.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Text().Empty() // will remove all Expect().Body().HTML().Select().Text().Empty() steps
+	Empty() IStep
+
+	// NotEmpty removes all previous NotEmpty() steps.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Attr("value").NotEmpty() // will remove all Expect().Body().HTML().Select().Attr("value").NotEmpty() steps
+	NotEmpty() IStep
+
+	// Len removes all previous Len() steps.
+	//
+	// If you specify an argument it will only remove the Len() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().HTML().Select().Attr("value").Len()   // will remove all Expect().Body().HTML().Select().Attr("value").Len() steps
+	//     Clear().Expect().Body().HTML().Select().Attr("value").Len(32) // will remove all Expect().Body().HTML().Select().Attr("value").Len(32) steps
+	Len(value ...interface{}) IStep
+}
+
+type clearExpectBodyHTML struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newClearExpectBodyHTML(cleanPath clearPath) IClearExpectBodyHTML {
+	return &clearExpectBodyHTML{
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (*clearExpectBodyHTML) when() StepTime {
+	return CleanStep
+}
+
+func (h *clearExpectBodyHTML) exec(hit Hit) error {
+	// this runs if we called Clear().Expect().Body().HTML()
+	if err := removeSteps(hit, h.clearPath()); err != nil {
+		return h.trace.Format(hit.Description(), err.Error())
+	}
+	return nil
+}
+
+func (h *clearExpectBodyHTML) clearPath() clearPath {
+	return h.cleanPath
+}
+
+func (h *clearExpectBodyHTML) Select(value ...interface{}) IClearExpectBodyHTMLSelection {
+	return newClearExpectBodyHTMLSelection(h.clearPath().Push("Select", value))
+}
+
+type clearExpectBodyHTMLSelection struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newClearExpectBodyHTMLSelection(cleanPath clearPath) IClearExpectBodyHTMLSelection {
+	return &clearExpectBodyHTMLSelection{
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (*clearExpectBodyHTMLSelection) when() StepTime {
+	return CleanStep
+}
+
+func (sel *clearExpectBodyHTMLSelection) exec(hit Hit) error {
+	// this runs if we called Clear().Expect().Body().HTML().Select()
+	if err := removeSteps(hit, sel.clearPath()); err != nil {
+		return sel.trace.Format(hit.Description(), err.Error())
+	}
+	return nil
+}
+
+func (sel *clearExpectBodyHTMLSelection) clearPath() clearPath {
+	return sel.cleanPath
+}
+
+func (sel *clearExpectBodyHTMLSelection) Exists() IStep {
+	return removeStep(sel.clearPath().Push("Exists", nil))
+}
+
+func (sel *clearExpectBodyHTMLSelection) NotExists() IStep {
+	return removeStep(sel.clearPath().Push("NotExists", nil))
+}
+
+func (sel *clearExpectBodyHTMLSelection) Count(value ...interface{}) IStep {
+	return removeStep(sel.clearPath().Push("Count", value))
+}
+
+func (sel *clearExpectBodyHTMLSelection) Text() IClearExpectBodyHTMLValue {
+	return newClearExpectBodyHTMLValue(sel.clearPath().Push("Text", nil))
+}
+
+func (sel *clearExpectBodyHTMLSelection) Attr(value ...interface{}) IClearExpectBodyHTMLValue {
+	return newClearExpectBodyHTMLValue(sel.clearPath().Push("Attr", value))
+}
+
+type clearExpectBodyHTMLValue struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newClearExpectBodyHTMLValue(cleanPath clearPath) IClearExpectBodyHTMLValue {
+	return &clearExpectBodyHTMLValue{
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (*clearExpectBodyHTMLValue) when() StepTime {
+	return CleanStep
+}
+
+func (v *clearExpectBodyHTMLValue) exec(hit Hit) error {
+	// this runs if we called Clear().Expect().Body().HTML().Select().Text() or Clear().Expect().Body().HTML().Select().Attr()
+	if err := removeSteps(hit, v.clearPath()); err != nil {
+		return v.trace.Format(hit.Description(), err.Error())
+	}
+	return nil
+}
+
+func (v *clearExpectBodyHTMLValue) clearPath() clearPath {
+	return v.cleanPath
+}
+
+func (v *clearExpectBodyHTMLValue) Equal(value ...interface{}) IStep {
+	return removeStep(v.clearPath().Push("Equal", value))
+}
+
+func (v *clearExpectBodyHTMLValue) NotEqual(value ...interface{}) IStep {
+	return removeStep(v.clearPath().Push("NotEqual", value))
+}
+
+func (v *clearExpectBodyHTMLValue) Contains(value ...interface{}) IStep {
+	return removeStep(v.clearPath().Push("Contains", value))
+}
+
+func (v *clearExpectBodyHTMLValue) NotContains(value ...interface{}) IStep {
+	return removeStep(v.clearPath().Push("NotContains", value))
+}
+
+func (v *clearExpectBodyHTMLValue) OneOf(value ...interface{}) IStep {
+	return removeStep(v.clearPath().Push("OneOf", value))
+}
+
+func (v *clearExpectBodyHTMLValue) NotOneOf(value ...interface{}) IStep {
+	return removeStep(v.clearPath().Push("NotOneOf", value))
+}
+
+func (v *clearExpectBodyHTMLValue) Empty() IStep {
+	return removeStep(v.clearPath().Push("Empty", nil))
+}
+
+func (v *clearExpectBodyHTMLValue) NotEmpty() IStep {
+	return removeStep(v.clearPath().Push("NotEmpty", nil))
+}
+
+func (v *clearExpectBodyHTMLValue) Len(value ...interface{}) IStep {
+	return removeStep(v.clearPath().Push("Len", value))
+}
+
+type finalClearExpectBodyHTML struct {
+	IStep
+	message string
+}
+
+func (h *finalClearExpectBodyHTML) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(h.message)
+		},
+	}
+}
+
+func (h *finalClearExpectBodyHTML) Select(...interface{}) IClearExpectBodyHTMLSelection {
+	return &finalClearExpectBodyHTMLSelection{
+		h.fail(),
+		h.message,
+	}
+}
+
+type finalClearExpectBodyHTMLSelection struct {
+	IStep
+	message string
+}
+
+func (sel *finalClearExpectBodyHTMLSelection) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(sel.message)
+		},
+	}
+}
+
+func (sel *finalClearExpectBodyHTMLSelection) Exists() IStep {
+	return sel.fail()
+}
+
+func (sel *finalClearExpectBodyHTMLSelection) NotExists() IStep {
+	return sel.fail()
+}
+
+func (sel *finalClearExpectBodyHTMLSelection) Count(...interface{}) IStep {
+	return sel.fail()
+}
+
+func (sel *finalClearExpectBodyHTMLSelection) Text() IClearExpectBodyHTMLValue {
+	return &finalClearExpectBodyHTMLValue{
+		sel.fail(),
+		sel.message,
+	}
+}
+
+func (sel *finalClearExpectBodyHTMLSelection) Attr(...interface{}) IClearExpectBodyHTMLValue {
+	return &finalClearExpectBodyHTMLValue{
+		sel.fail(),
+		sel.message,
+	}
+}
+
+type finalClearExpectBodyHTMLValue struct {
+	IStep
+	message string
+}
+
+func (v *finalClearExpectBodyHTMLValue) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(v.message)
+		},
+	}
+}
+
+func (v *finalClearExpectBodyHTMLValue) Equal(...interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalClearExpectBodyHTMLValue) NotEqual(...interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalClearExpectBodyHTMLValue) Contains(...interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalClearExpectBodyHTMLValue) NotContains(...interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalClearExpectBodyHTMLValue) OneOf(...interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalClearExpectBodyHTMLValue) NotOneOf(...interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalClearExpectBodyHTMLValue) Empty() IStep {
+	return v.fail()
+}
+
+func (v *finalClearExpectBodyHTMLValue) NotEmpty() IStep {
+	return v.fail()
+}
+
+func (v *finalClearExpectBodyHTMLValue) Len(...interface{}) IStep {
+	return v.fail()
+}
@@ -0,0 +1,76 @@
+package hit_test
+
+import (
+	"testing"
+
+	. "github.com/Eun/go-hit"
+)
+
+func TestClearExpectBodyHTML(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(htmlLogin),
+			Expect().Body().HTML().Select("h1").Text().Equal("Goodbye"),
+			Expect().Body().HTML().Select("li").Count(1),
+			Clear().Expect().Body().HTML(),
+		)
+	})
+
+	t.Run("select", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(htmlLogin),
+				Expect().Body().HTML().Select("h1").Text().Equal("Goodbye"),
+				Expect().Body().HTML().Select("li").Count(1),
+				Clear().Expect().Body().HTML().Select("h1"),
+			),
+			PtrStr("li should match 1 element(s), but matches 3"),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(htmlLogin),
+			Expect().Body().HTML().Select("h2").Exists(),
+			Expect().Body().HTML().Select("h1").NotExists(),
+			Expect().Body().HTML().Select("li").Count(1),
+			Expect().Body().HTML().Select("h1").Text().Equal("Goodbye"),
+			Expect().Body().HTML().Select("h1").Text().NotEqual("Welcome back"),
+			Expect().Body().HTML().Select("h1").Text().Contains("Goodbye"),
+			Expect().Body().HTML().Select("h1").Text().NotContains("Welcome"),
+			Expect().Body().HTML().Select("h1").Text().OneOf("Goodbye"),
+			Expect().Body().HTML().Select("h1").Text().NotOneOf("Welcome back"),
+			Expect().Body().HTML().Select("h1").Text().Empty(),
+			Expect().Body().HTML().Select("input[name=user]").Attr("value").NotEmpty(),
+			Expect().Body().HTML().Select("input[name=csrf]").Attr("value").Len(3),
+			Clear().Expect().Body().HTML().Select("h2").Exists(),
+			Clear().Expect().Body().HTML().Select("h1").NotExists(),
+			Clear().Expect().Body().HTML().Select().Count(1),
+			Clear().Expect().Body().HTML().Select("h1").Text().Equal("Goodbye"),
+			Clear().Expect().Body().HTML().Select("h1").Text().NotEqual(),
+			Clear().Expect().Body().HTML().Select("h1").Text().Contains(),
+			Clear().Expect().Body().HTML().Select("h1").Text().NotContains(),
+			Clear().Expect().Body().HTML().Select("h1").Text().OneOf(),
+			Clear().Expect().Body().HTML().Select("h1").Text().NotOneOf(),
+			Clear().Expect().Body().HTML().Select("h1").Text().Empty(),
+			Clear().Expect().Body().HTML().Select().Attr("value").NotEmpty(),
+			Clear().Expect().Body().HTML().Select().Attr().Len(3),
+		)
+	})
+
+	t.Run("final", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Clear().Expect().Body("Hello").HTML().Select("h1"),
+			),
+			PtrStr("only usable with Clear().Expect().Body() not with Clear().Expect().Body(value)"),
+		)
+	})
+}
@@ -88,6 +88,175 @@ type IClearExpectBodyJSON interface {
 	//         Expect().Body().JSON().NotContains("Name", "Alice"),
 	//     )
 	NotContains(value ...interface{}) IStep
+
+	// Subset removes all previous Expect().Body().JSON().Subset() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().Subset() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().Subset()                  // will remove all Expect().Body().JSON().Subset() steps
+	//     Clear().Expect().Body().JSON().Subset("")                // will remove all Expect().Body().JSON().Subset("", ...) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().JSON().Subset("", map[string]interface{}{"Name": "Joe"}),
+	//         Clear().Expect().Body().JSON().Subset(),
+	//         Expect().Body().JSON().Subset("", map[string]interface{}{"Name": "Alice"}),
+	//     )
+	Subset(value ...interface{}) IStep
+
+	// Matches removes all previous Expect().Body().JSON().Matches() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().Matches() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().Matches()                 // will remove all Expect().Body().JSON().Matches() steps
+	//     Clear().Expect().Body().JSON().Matches("Name")           // will remove all Expect().Body().JSON().Matches("Name", ...) steps
+	//     Clear().Expect().Body().JSON().Matches("Name", `^J\w+$`) // will remove all Expect().Body().JSON().Matches("Name", `^J\w+$`) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().JSON().Matches("Name", `^J\w+$`),
+	//         Clear().Expect().Body().JSON().Matches("Name"),
+	//         Expect().Body().JSON().Matches("Name", `^A\w+$`),
+	//     )
+	Matches(value ...interface{}) IStep
+
+	// NotMatches removes all previous Expect().Body().JSON().NotMatches() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().NotMatches() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().NotMatches()             // will remove all Expect().Body().JSON().NotMatches() steps
+	//     Clear().Expect().Body().JSON().NotMatches("Name")       // will remove all Expect().Body().JSON().NotMatches("Name", ...) steps
+	//     Clear().Expect().Body().JSON().NotMatches("Name", `^J`) // will remove all Expect().Body().JSON().NotMatches("Name", `^J`) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().JSON().NotMatches("Name", `^J`),
+	//         Clear().Expect().Body().JSON().NotMatches("Name"),
+	//         Expect().Body().JSON().NotMatches("Name", `^A`),
+	//     )
+	NotMatches(value ...interface{}) IStep
+
+	// Len removes all previous Expect().Body().JSON().Len() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().Len() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().Len()           // will remove all Expect().Body().JSON().Len() steps
+	//     Clear().Expect().Body().JSON().Len("Items")    // will remove all Expect().Body().JSON().Len("Items", ...) steps
+	//     Clear().Expect().Body().JSON().Len("Items", 2) // will remove all Expect().Body().JSON().Len("Items", 2) steps
+	Len(value ...interface{}) IStep
+
+	// Exists removes all previous Expect().Body().JSON().Exists() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().Exists() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().Exists()            // will remove all Expect().Body().JSON().Exists() steps
+	//     Clear().Expect().Body().JSON().Exists("Meta.Next") // will remove all Expect().Body().JSON().Exists("Meta.Next") steps
+	Exists(value ...interface{}) IStep
+
+	// NotExists removes all previous Expect().Body().JSON().NotExists() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().NotExists() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().NotExists()           // will remove all Expect().Body().JSON().NotExists() steps
+	//     Clear().Expect().Body().JSON().NotExists("Password") // will remove all Expect().Body().JSON().NotExists("Password") steps
+	NotExists(value ...interface{}) IStep
+
+	// Type removes all previous Expect().Body().JSON().Type() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().Type() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().Type()                 // will remove all Expect().Body().JSON().Type() steps
+	//     Clear().Expect().Body().JSON().Type("Items")          // will remove all Expect().Body().JSON().Type("Items", ...) steps
+	//     Clear().Expect().Body().JSON().Type("Items", "array") // will remove all Expect().Body().JSON().Type("Items", "array") steps
+	Type(value ...interface{}) IStep
+
+	// GreaterThan removes all previous Expect().Body().JSON().GreaterThan() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().GreaterThan() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().GreaterThan()           // will remove all Expect().Body().JSON().GreaterThan() steps
+	//     Clear().Expect().Body().JSON().GreaterThan("Count")    // will remove all Expect().Body().JSON().GreaterThan("Count", ...) steps
+	//     Clear().Expect().Body().JSON().GreaterThan("Count", 0) // will remove all Expect().Body().JSON().GreaterThan("Count", 0) steps
+	GreaterThan(value ...interface{}) IStep
+
+	// LessThan removes all previous Expect().Body().JSON().LessThan() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().LessThan() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().LessThan()            // will remove all Expect().Body().JSON().LessThan() steps
+	//     Clear().Expect().Body().JSON().LessThan("Count")     // will remove all Expect().Body().JSON().LessThan("Count", ...) steps
+	//     Clear().Expect().Body().JSON().LessThan("Count", 10) // will remove all Expect().Body().JSON().LessThan("Count", 10) steps
+	LessThan(value ...interface{}) IStep
+
+	// GreaterOrEqualThan removes all previous Expect().Body().JSON().GreaterOrEqualThan() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().GreaterOrEqualThan() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().GreaterOrEqualThan()           // will remove all Expect().Body().JSON().GreaterOrEqualThan() steps
+	//     Clear().Expect().Body().JSON().GreaterOrEqualThan("Count")    // will remove all Expect().Body().JSON().GreaterOrEqualThan("Count", ...) steps
+	//     Clear().Expect().Body().JSON().GreaterOrEqualThan("Count", 0) // will remove all Expect().Body().JSON().GreaterOrEqualThan("Count", 0) steps
+	GreaterOrEqualThan(value ...interface{}) IStep
+
+	// LessOrEqualThan removes all previous Expect().Body().JSON().LessOrEqualThan() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().LessOrEqualThan() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().LessOrEqualThan()            // will remove all Expect().Body().JSON().LessOrEqualThan() steps
+	//     Clear().Expect().Body().JSON().LessOrEqualThan("Count")     // will remove all Expect().Body().JSON().LessOrEqualThan("Count", ...) steps
+	//     Clear().Expect().Body().JSON().LessOrEqualThan("Count", 10) // will remove all Expect().Body().JSON().LessOrEqualThan("Count", 10) steps
+	LessOrEqualThan(value ...interface{}) IStep
+
+	// Between removes all previous Expect().Body().JSON().Between() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().Between() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().Between()               // will remove all Expect().Body().JSON().Between() steps
+	//     Clear().Expect().Body().JSON().Between("Price")        // will remove all Expect().Body().JSON().Between("Price", ...) steps
+	//     Clear().Expect().Body().JSON().Between("Price", 1, 10) // will remove all Expect().Body().JSON().Between("Price", 1, 10) steps
+	Between(value ...interface{}) IStep
+
+	// OneOf removes all previous Expect().Body().JSON().OneOf() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().OneOf() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().OneOf()                        // will remove all Expect().Body().JSON().OneOf() steps
+	//     Clear().Expect().Body().JSON().OneOf("Name")                  // will remove all Expect().Body().JSON().OneOf("Name", ...) steps
+	//     Clear().Expect().Body().JSON().OneOf("Name", "Apple", "Pear") // will remove all Expect().Body().JSON().OneOf("Name", "Apple", "Pear") steps
+	OneOf(value ...interface{}) IStep
+
+	// Each removes all previous Expect().Body().JSON().Each() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().Each() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().Each()        // will remove all Expect().Body().JSON().Each() steps
+	//     Clear().Expect().Body().JSON().Each("Items") // will remove all Expect().Body().JSON().Each("Items") steps
+	Each(value ...interface{}) IStep
+
+	// Any removes all previous Expect().Body().JSON().Any() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().JSON().Any() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().JSON().Any()        // will remove all Expect().Body().JSON().Any() steps
+	//     Clear().Expect().Body().JSON().Any("Items") // will remove all Expect().Body().JSON().Any("Items") steps
+	Any(value ...interface{}) IStep
 }
 
 type clearExpectBodyJSON struct {
@@ -143,6 +312,66 @@ func (jsn *clearExpectBodyJSON) NotContains(value ...interface{}) IStep {
 	return removeStep(jsn.clearPath().Push("NotContains", value))
 }
 
+func (jsn *clearExpectBodyJSON) Subset(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("Subset", value))
+}
+
+func (jsn *clearExpectBodyJSON) Matches(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("Matches", patternArguments(value)))
+}
+
+func (jsn *clearExpectBodyJSON) NotMatches(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("NotMatches", patternArguments(value)))
+}
+
+func (jsn *clearExpectBodyJSON) Len(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("Len", value))
+}
+
+func (jsn *clearExpectBodyJSON) Exists(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("Exists", value))
+}
+
+func (jsn *clearExpectBodyJSON) NotExists(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("NotExists", value))
+}
+
+func (jsn *clearExpectBodyJSON) Type(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("Type", value))
+}
+
+func (jsn *clearExpectBodyJSON) GreaterThan(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("GreaterThan", value))
+}
+
+func (jsn *clearExpectBodyJSON) LessThan(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("LessThan", value))
+}
+
+func (jsn *clearExpectBodyJSON) GreaterOrEqualThan(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("GreaterOrEqualThan", value))
+}
+
+func (jsn *clearExpectBodyJSON) LessOrEqualThan(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("LessOrEqualThan", value))
+}
+
+func (jsn *clearExpectBodyJSON) Between(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("Between", value))
+}
+
+func (jsn *clearExpectBodyJSON) OneOf(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("OneOf", value))
+}
+
+func (jsn *clearExpectBodyJSON) Each(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("Each", value))
+}
+
+func (jsn *clearExpectBodyJSON) Any(value ...interface{}) IStep {
+	return removeStep(jsn.clearPath().Push("Any", value))
+}
+
 type finalClearExpectBodyJSON struct {
 	IStep
 	message string
@@ -174,3 +403,63 @@ func (jsn *finalClearExpectBodyJSON) Contains(...interface{}) IStep {
 func (jsn *finalClearExpectBodyJSON) NotContains(...interface{}) IStep {
 	return jsn.fail()
 }
+
+func (jsn *finalClearExpectBodyJSON) Subset(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) Matches(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) NotMatches(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) Len(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) Exists(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) NotExists(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) Type(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) GreaterThan(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) LessThan(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) GreaterOrEqualThan(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) LessOrEqualThan(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) Between(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) OneOf(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) Each(...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalClearExpectBodyJSON) Any(...interface{}) IStep {
+	return jsn.fail()
+}
@@ -222,3 +222,71 @@ func TestClearExpectBodyJSON_NotExistentStep(t *testing.T) {
 		PtrStr(`unable to find a step with Expect().Body().JSON()`), PtrStr(`got these steps:`), PtrStr(`Send().Body("Hello World")`),
 	)
 }
+
+func TestClearExpectBodyJSON_Matches(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+		Expect().Body().JSON().Matches("Name", `^A`),
+		Expect().Body().JSON().NotMatches("Name", `^J`),
+		Clear().Expect().Body().JSON().Matches("Name"),
+		Clear().Expect().Body().JSON().NotMatches("Name", `^J`),
+	)
+}
+
+func TestClearExpectBodyJSON_Assertions(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("specific only first parameter", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body().JSON(map[string]interface{}{"Name": "Joe", "Count": 10, "Items": []int{1, 2}}),
+			Expect().Body().JSON().Len("Items", 3),
+			Expect().Body().JSON().Exists("Password"),
+			Expect().Body().JSON().NotExists("Name"),
+			Expect().Body().JSON().Type("Name", "number"),
+			Expect().Body().JSON().GreaterThan("Count", 10),
+			Expect().Body().JSON().LessThan("Count", 10),
+			Expect().Body().JSON().GreaterOrEqualThan("Count", 11),
+			Expect().Body().JSON().LessOrEqualThan("Count", 9),
+			Expect().Body().JSON().Between("Count", 1, 5),
+			Expect().Body().JSON().OneOf("Name", "Alice", "Bob"),
+			Expect().Body().JSON().Each("Items", func(e IExpectJSONValue) {
+				e.Equal("", 0)
+			}),
+			Expect().Body().JSON().Any("Items", func(e IExpectJSONValue) {
+				e.Equal("", 0)
+			}),
+			Clear().Expect().Body().JSON().Len("Items"),
+			Clear().Expect().Body().JSON().Exists("Password"),
+			Clear().Expect().Body().JSON().NotExists("Name"),
+			Clear().Expect().Body().JSON().Type("Name", "number"),
+			Clear().Expect().Body().JSON().GreaterThan("Count"),
+			Clear().Expect().Body().JSON().LessThan("Count", 10),
+			Clear().Expect().Body().JSON().GreaterOrEqualThan("Count"),
+			Clear().Expect().Body().JSON().LessOrEqualThan("Count"),
+			Clear().Expect().Body().JSON().Between("Count", 1, 5),
+			Clear().Expect().Body().JSON().OneOf("Name"),
+			Clear().Expect().Body().JSON().Each("Items"),
+			Clear().Expect().Body().JSON().Any(),
+		)
+	})
+}
+
+func TestClearExpectBodyJSON_Subset(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body().JSON(map[string]interface{}{"Name": "Joe", "ID": 10}),
+		Expect().Body().JSON().Subset("", map[string]interface{}{"Name": "Alice"}),
+		Expect().Body().JSON().Subset("", map[string]interface{}{"ID": 11}, IgnorePaths("Name")),
+		Clear().Expect().Body().JSON().Subset(""),
+		Expect().Body().JSON().Subset("", map[string]interface{}{"Name": "Joe"}),
+	)
+}
@@ -1,6 +1,7 @@
 package hit_test
 
 import (
+	"regexp"
 	"testing"
 
 	. "github.com/Eun/go-hit"
@@ -262,3 +263,19 @@ func TestClearExpectBody_NotExistentStep(t *testing.T) {
 		PtrStr(`unable to find a step with Expect().Body()`), PtrStr(`got these steps:`), PtrStr(`Send().Body("Hello World")`),
 	)
 }
+
+func TestClearExpectBody_Matches(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body("Hello World"),
+		Expect().Body().Matches(`^Hello Earth$`),
+		Expect().Body().Matches(regexp.MustCompile(`^Hello Universe$`)),
+		Expect().Body().NotMatches(`World`),
+		Clear().Expect().Body().Matches(`^Hello Earth$`),
+		Clear().Expect().Body().Matches(regexp.MustCompile(`^Hello Universe$`)),
+		Clear().Expect().Body().NotMatches(),
+	)
+}
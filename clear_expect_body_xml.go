@@ -0,0 +1,244 @@
+package hit
+
+import (
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal"
+	"golang.org/x/xerrors"
+)
+
+// IClearExpectBodyXML provides a clear functionality to remove previous steps from running in the Expect().Body().XML() scope
+type IClearExpectBodyXML interface {
+	IStep
+	// Equal removes all previous Expect().Body().XML().Equal() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().XML().Equal() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().XML().Equal()                          // will remove all Expect().Body().XML().Equal() steps
+	//     Clear().Expect().Body().XML().Equal("/user/name")              // will remove all Expect().Body().XML().Equal("/user/name", ...) steps
+	//     Clear().Expect().Body().XML().Equal("/user/name", "Joe")       // will remove all Expect().Body().XML().Equal("/user/name", "Joe") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().XML().Equal("/user/name", "Joe"),
+	//         Clear().Expect().Body().XML().Equal("/user/name"),
+	//         Expect().Body().XML().Equal("/user/name", "Alice"),
+	//     )
+	Equal(value ...interface{}) IStep
+
+	// NotEqual removes all previous Expect().Body().XML().NotEqual() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().XML().NotEqual() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().XML().NotEqual()                       // will remove all Expect().Body().XML().NotEqual() steps
+	//     Clear().Expect().Body().XML().NotEqual("/user/name")           // will remove all Expect().Body().XML().NotEqual("/user/name", ...) steps
+	//     Clear().Expect().Body().XML().NotEqual("/user/name", "Joe")    // will remove all Expect().Body().XML().NotEqual("/user/name", "Joe") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().XML().NotEqual("/user/name", "Joe"),
+	//         Clear().Expect().Body().XML().NotEqual("/user/name"),
+	//         Expect().Body().XML().NotEqual("/user/name", "Alice"),
+	//     )
+	NotEqual(value ...interface{}) IStep
+
+	// Contains removes all previous Expect().Body().XML().Contains() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().XML().Contains() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().XML().Contains()                       // will remove all Expect().Body().XML().Contains() steps
+	//     Clear().Expect().Body().XML().Contains("/user/role")           // will remove all Expect().Body().XML().Contains("/user/role", ...) steps
+	//     Clear().Expect().Body().XML().Contains("/user/role", "Admin")  // will remove all Expect().Body().XML().Contains("/user/role", "Admin") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().XML().Contains("/user/role", "Admin"),
+	//         Clear().Expect().Body().XML().Contains("/user/role"),
+	//         Expect().Body().XML().Contains("/user/role", "User"),
+	//     )
+	Contains(value ...interface{}) IStep
+
+	// NotContains removes all previous Expect().Body().XML().NotContains() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().XML().NotContains() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().XML().NotContains()                      // will remove all Expect().Body().XML().NotContains() steps
+	//     Clear().Expect().Body().XML().NotContains("/user/role")          // will remove all Expect().Body().XML().NotContains("/user/role", ...) steps
+	//     Clear().Expect().Body().XML().NotContains("/user/role", "Admin") // will remove all Expect().Body().XML().NotContains("/user/role", "Admin") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().XML().NotContains("/user/role", "Admin"),
+	//         Clear().Expect().Body().XML().NotContains("/user/role"),
+	//         Expect().Body().XML().NotContains("/user/role", "Guest"),
+	//     )
+	NotContains(value ...interface{}) IStep
+
+	// Len removes all previous Expect().Body().XML().Len() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().XML().Len() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().XML().Len()                  // will remove all Expect().Body().XML().Len() steps
+	//     Clear().Expect().Body().XML().Len("/user/role")      // will remove all Expect().Body().XML().Len("/user/role", ...) steps
+	//     Clear().Expect().Body().XML().Len("/user/role", 2)   // will remove all Expect().Body().XML().Len("/user/role", 2) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().XML().Len("/user/role", 2),
+	//         Clear().Expect().Body().XML().Len(),
+	//         Expect().Body().XML().Len("/user/role", 3),
+	//     )
+	Len(value ...interface{}) IStep
+
+	// Exists removes all previous Expect().Body().XML().Exists() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().XML().Exists() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().XML().Exists()              // will remove all Expect().Body().XML().Exists() steps
+	//     Clear().Expect().Body().XML().Exists("/user/@id")   // will remove all Expect().Body().XML().Exists("/user/@id") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().XML().Exists("/user/@id"),
+	//         Clear().Expect().Body().XML().Exists(),
+	//         Expect().Body().XML().Exists("/user/name"),
+	//     )
+	Exists(value ...interface{}) IStep
+
+	// NotExists removes all previous Expect().Body().XML().NotExists() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Body().XML().NotExists() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Body().XML().NotExists()                  // will remove all Expect().Body().XML().NotExists() steps
+	//     Clear().Expect().Body().XML().NotExists("/user/password")  // will remove all Expect().Body().XML().NotExists("/user/password") steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Body().XML().NotExists("/user/password"),
+	//         Clear().Expect().Body().XML().NotExists(),
+	//         Expect().Body().XML().NotExists("/user/secret"),
+	//     )
+	NotExists(value ...interface{}) IStep
+}
+
+type clearExpectBodyXML struct {
+	clearExpectBody IClearExpectBody
+	cleanPath       clearPath
+	trace           *errortrace.ErrorTrace
+}
+
+func newClearExpectBodyXML(body IClearExpectBody, cleanPath clearPath, params []interface{}) IClearExpectBodyXML {
+	if _, ok := internal.GetLastArgument(params); ok {
+		// this runs if we called Clear().Expect().Body().XML(something)
+		return &finalClearExpectBodyXML{
+			removeStep(cleanPath),
+			"only usable with Clear().Expect().Body().XML() not with Clear().Expect().Body().XML(value)",
+		}
+	}
+	return &clearExpectBodyXML{
+		clearExpectBody: body,
+		cleanPath:       cleanPath,
+		trace:           ett.Prepare(),
+	}
+}
+
+func (x *clearExpectBodyXML) when() StepTime {
+	return CleanStep
+}
+
+func (x *clearExpectBodyXML) exec(hit Hit) error {
+	// this runs if we called Clear().Expect().Body().XML()
+	if err := removeSteps(hit, x.clearPath()); err != nil {
+		return x.trace.Format(hit.Description(), err.Error())
+	}
+	return nil
+}
+
+func (x *clearExpectBodyXML) clearPath() clearPath {
+	return x.cleanPath
+}
+
+func (x *clearExpectBodyXML) Equal(value ...interface{}) IStep {
+	return removeStep(x.clearPath().Push("Equal", value))
+}
+
+func (x *clearExpectBodyXML) NotEqual(value ...interface{}) IStep {
+	return removeStep(x.clearPath().Push("NotEqual", value))
+}
+
+func (x *clearExpectBodyXML) Contains(value ...interface{}) IStep {
+	return removeStep(x.clearPath().Push("Contains", value))
+}
+
+func (x *clearExpectBodyXML) NotContains(value ...interface{}) IStep {
+	return removeStep(x.clearPath().Push("NotContains", value))
+}
+
+func (x *clearExpectBodyXML) Len(value ...interface{}) IStep {
+	return removeStep(x.clearPath().Push("Len", value))
+}
+
+func (x *clearExpectBodyXML) Exists(value ...interface{}) IStep {
+	return removeStep(x.clearPath().Push("Exists", value))
+}
+
+func (x *clearExpectBodyXML) NotExists(value ...interface{}) IStep {
+	return removeStep(x.clearPath().Push("NotExists", value))
+}
+
+type finalClearExpectBodyXML struct {
+	IStep
+	message string
+}
+
+func (x *finalClearExpectBodyXML) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(x.message)
+		},
+	}
+}
+
+func (x *finalClearExpectBodyXML) Equal(...interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalClearExpectBodyXML) NotEqual(...interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalClearExpectBodyXML) Contains(...interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalClearExpectBodyXML) NotContains(...interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalClearExpectBodyXML) Len(...interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalClearExpectBodyXML) Exists(...interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalClearExpectBodyXML) NotExists(...interface{}) IStep {
+	return x.fail()
+}
@@ -0,0 +1,84 @@
+package hit_test
+
+import (
+	"testing"
+
+	. "github.com/Eun/go-hit"
+)
+
+func TestClearExpectBodyXML(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(xmlUser),
+				Expect().Body().XML().Equal("/user/name", "Alice"),
+				Expect().Body().XML().Len("/user/role", 3),
+				Clear().Expect().Body().XML(),
+				Expect().Body().XML().Equal("/user/name", "Bob"),
+			),
+			PtrStr("Not equal"), PtrStr(`expected: "Bob"`), PtrStr(`actual: "Joe"`), nil, nil, nil, nil,
+		)
+	})
+
+	t.Run("specific only first parameter", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML().Equal("/user/name", "Alice"),
+			Expect().Body().XML().Equal("/user/name", "Bob"),
+			Expect().Body().XML().NotEqual("/user/name", "Joe"),
+			Expect().Body().XML().Contains("/user/role", "Guest"),
+			Expect().Body().XML().NotContains("/user/role", "Admin"),
+			Expect().Body().XML().Len("/user/role", 3),
+			Expect().Body().XML().Exists("/user/password"),
+			Expect().Body().XML().NotExists("/user/name"),
+			Clear().Expect().Body().XML().Equal("/user/name"),
+			Clear().Expect().Body().XML().NotEqual("/user/name"),
+			Clear().Expect().Body().XML().Contains("/user/role"),
+			Clear().Expect().Body().XML().NotContains("/user/role"),
+			Clear().Expect().Body().XML().Len("/user/role"),
+			Clear().Expect().Body().XML().Exists("/user/password"),
+			Clear().Expect().Body().XML().NotExists("/user/name"),
+		)
+	})
+
+	t.Run("specific (all)", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(xmlUser),
+				Expect().Body().XML().Equal("/user/name", "Alice"),
+				Expect().Body().XML().Equal("/user/name", "Bob"),
+				Clear().Expect().Body().XML().Equal("/user/name", "Alice"),
+			),
+			PtrStr("Not equal"), PtrStr(`expected: "Bob"`), PtrStr(`actual: "Joe"`), nil, nil, nil, nil,
+		)
+	})
+
+	t.Run("final", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Clear().Expect().Body().XML("Joe").Equal(),
+			),
+			PtrStr("only usable with Clear().Expect().Body().XML() not with Clear().Expect().Body().XML(value)"),
+		)
+	})
+}
+
+func TestClearSendBodyXML(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body().XML(xmlUserType{Name: "Alice"}),
+		Clear().Send().Body().XML(),
+		Send().Body().XML(xmlUserType{Name: "Joe"}),
+		Expect().Body().XML().Equal("/user/name", "Joe"),
+	)
+}
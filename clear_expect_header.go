@@ -193,6 +193,42 @@ type IClearExpectHeader interface {
 	//         Expect().Header("Content-Type").NotEqual("application/xml")
 	//     )
 	NotEqual(value ...interface{}) IStep
+
+	// Matches removes all previous Expect().Header(...).Matches() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Header(...).Matches() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Header().Matches()                           // will remove all Expect().Header().Matches() steps
+	//     Clear().Expect().Header("Content-Type").Matches()             // will remove all Expect().Header("Content-Type").Matches() steps
+	//     Clear().Expect().Header("Content-Type").Matches(`json$`)      // will remove all Expect().Header("Content-Type").Matches(`json$`) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Header("Content-Type").Matches(`xml$`),
+	//         Clear().Expect().Header("Content-Type").Matches(),
+	//         Expect().Header("Content-Type").Matches(`json$`),
+	//     )
+	Matches(value ...interface{}) IStep
+
+	// NotMatches removes all previous Expect().Header(...).NotMatches() steps.
+	//
+	// If you specify an argument it will only remove the Expect().Header(...).NotMatches() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Expect().Header().NotMatches()                        // will remove all Expect().Header().NotMatches() steps
+	//     Clear().Expect().Header("Content-Type").NotMatches()          // will remove all Expect().Header("Content-Type").NotMatches() steps
+	//     Clear().Expect().Header("Content-Type").NotMatches(`xml$`)    // will remove all Expect().Header("Content-Type").NotMatches(`xml$`) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Header("Content-Type").NotMatches(`json$`),
+	//         Clear().Expect().Header("Content-Type").NotMatches(),
+	//         Expect().Header("Content-Type").NotMatches(`xml$`),
+	//     )
+	NotMatches(value ...interface{}) IStep
 }
 type clearExpectHeader struct {
 	cleanPath clearPath
@@ -258,6 +294,14 @@ func (hdr *clearExpectHeader) NotEqual(value ...interface{}) IStep {
 	return removeStep(hdr.clearPath().Push("NotEqual", value))
 }
 
+func (hdr *clearExpectHeader) Matches(value ...interface{}) IStep {
+	return removeStep(hdr.clearPath().Push("Matches", patternArguments(value)))
+}
+
+func (hdr *clearExpectHeader) NotMatches(value ...interface{}) IStep {
+	return removeStep(hdr.clearPath().Push("NotMatches", patternArguments(value)))
+}
+
 type finalClearExpectHeader struct {
 	IStep
 	message string
@@ -305,3 +349,11 @@ func (hdr *finalClearExpectHeader) Equal(...interface{}) IStep {
 func (hdr *finalClearExpectHeader) NotEqual(...interface{}) IStep {
 	return hdr.fail()
 }
+
+func (hdr *finalClearExpectHeader) Matches(...interface{}) IStep {
+	return hdr.fail()
+}
+
+func (hdr *finalClearExpectHeader) NotMatches(...interface{}) IStep {
+	return hdr.fail()
+}
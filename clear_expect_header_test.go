@@ -314,3 +314,19 @@ func TestClearExpectHeader_NotExistentStep(t *testing.T) {
 		PtrStr(`unable to find a step with Expect().Header()`), PtrStr(`got these steps:`), PtrStr(`Send().Body("Hello World")`),
 	)
 }
+
+func TestClearExpectHeader_Matches(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Header("X-Header", "Hello"),
+		Expect().Header("X-Header").Matches(`^World$`),
+		Expect().Header("X-Header").NotMatches(`Hello`),
+		Expect().Header().Matches(`^X-Debug`),
+		Clear().Expect().Header("X-Header").Matches(),
+		Clear().Expect().Header("X-Header").NotMatches(`Hello`),
+		Clear().Expect().Header().Matches(`^X-Debug`),
+	)
+}
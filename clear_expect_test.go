@@ -1,6 +1,7 @@
 package hit_test
 
 import (
+	"crypto/tls"
 	"testing"
 
 	"net/http"
@@ -174,6 +175,62 @@ func TestClearExpect_Custom(t *testing.T) {
 	})
 }
 
+func TestClearExpect_Trailer(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Expect().Trailer("Grpc-Status").Equal("0"),
+			Expect().Trailer().Contains("Grpc-Status"),
+			Clear().Expect().Trailer(),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Expect().Trailer("Grpc-Status").Equal("0"),
+				Expect().Trailer().Contains("Grpc-Message"),
+				Clear().Expect().Trailer("Grpc-Status"),
+			),
+			PtrStr(`http.Header{} does not contain "Grpc-Message"`),
+		)
+	})
+}
+
+func TestClearExpect_Chains(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	tests := []struct {
+		name   string
+		expect IStep
+		clear  IStep
+	}{
+		{"Proto", Expect().Proto().Equal("HTTP/2.0"), Clear().Expect().Proto()},
+		{"SSE", Expect().SSE().Next().Data().Equal("Hello"), Clear().Expect().SSE()},
+		{"Message", Expect().Message("Hello"), Clear().Expect().Message()},
+		{"GraphQL", Expect().GraphQL().NoErrors(), Clear().Expect().GraphQL()},
+		{"JSONRPC", Expect().JSONRPC().NoError(), Clear().Expect().JSONRPC()},
+		{"Redirect", Expect().Redirect().To("/login"), Clear().Expect().Redirect()},
+		{"Redirects", Expect().Redirects().Len(1), Clear().Expect().Redirects()},
+		{"TLS", Expect().TLS().Version(tls.VersionTLS13), Clear().Expect().TLS()},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			Test(t,
+				Post(s.URL),
+				test.expect,
+				test.clear,
+			)
+		})
+	}
+}
+
 func TestClearExpect_Final(t *testing.T) {
 	s := EchoServer()
 	defer s.Close()
@@ -191,6 +248,20 @@ func TestClearExpect_Final(t *testing.T) {
 		)
 	})
 
+	t.Run("Clear().Expect(value).Trailer()", func(t *testing.T) {
+		ExpectError(t,
+			Do(Clear().Expect("Data").Trailer()),
+			PtrStr("only usable with Clear().Expect() not with Clear().Expect(value)"),
+		)
+	})
+
+	t.Run("Clear().Expect(value).Proto()", func(t *testing.T) {
+		ExpectError(t,
+			Do(Clear().Expect("Data").Proto()),
+			PtrStr("only usable with Clear().Expect() not with Clear().Expect(value)"),
+		)
+	})
+
 	t.Run("Clear().Expect(value).Interface()", func(t *testing.T) {
 		ExpectError(t,
 			Do(Clear().Expect("Data").Interface()),
@@ -100,6 +100,58 @@ type IClearSend interface {
 	//         }),
 	//     )
 	Custom(fn ...Callback) IStep
+
+	// GraphQL removes all previous Send().GraphQL() steps.
+	//
+	// If you specify an argument it will only remove the Send().GraphQL() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Send().GraphQL()                    // will remove all Send().GraphQL() steps
+	//     Clear().Send().GraphQL("{ user { name } }") // will remove all Send().GraphQL("{ user { name } }", ...) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/graphql"),
+	//         Send().GraphQL("{ user { name } }", nil),
+	//         Clear().Send().GraphQL(),
+	//         Send().GraphQL("{ user { id } }", nil),
+	//     )
+	GraphQL(values ...interface{}) IStep
+
+	// JSONRPC removes all previous Send().JSONRPC() steps.
+	//
+	// If you specify an argument it will only remove the Send().JSONRPC() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Send().JSONRPC()      // will remove all Send().JSONRPC() steps
+	//     Clear().Send().JSONRPC("sum") // will remove all Send().JSONRPC("sum", ...) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/rpc"),
+	//         Send().JSONRPC("sum", []int{1, 2}),
+	//         Clear().Send().JSONRPC(),
+	//         Send().JSONRPC("sum", []int{3, 4}),
+	//     )
+	JSONRPC(values ...interface{}) IStep
+
+	// Message removes all previous Send().Message() steps and all steps chained to Send().Message()
+	// e.g. Send().Message().JSON(map[string]interface{}{"Command": "Quit"}).
+	//
+	// If you specify an argument it will only remove the Send().Message() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Send().Message()        // will remove all Send().Message() steps and all chained steps to Send().Message()
+	//     Clear().Send().Message("Hello") // will remove all Send().Message("Hello") steps
+	//
+	// Example:
+	//     MustDo(
+	//         WebSocket("ws://example.com/chat"),
+	//         Send().Message("Hello"),
+	//         Clear().Send().Message(),
+	//         Send().Message("Hi"),
+	//     )
+	Message(value ...interface{}) IStep
 }
 
 type clearSend struct {
@@ -163,6 +215,18 @@ func (snd *clearSend) Header(values ...interface{}) IStep {
 	return removeStep(snd.clearPath().Push("Header", values))
 }
 
+func (snd *clearSend) GraphQL(values ...interface{}) IStep {
+	return removeStep(snd.clearPath().Push("GraphQL", values))
+}
+
+func (snd *clearSend) JSONRPC(values ...interface{}) IStep {
+	return removeStep(snd.clearPath().Push("JSONRPC", values))
+}
+
+func (snd *clearSend) Message(value ...interface{}) IStep {
+	return removeStep(snd.clearPath().Push("Message", value))
+}
+
 type finalClearSend struct {
 	IStep
 	message string
@@ -201,3 +265,15 @@ func (snd *finalClearSend) Header(...interface{}) IStep {
 func (snd *finalClearSend) Interface(...interface{}) IStep {
 	return snd.fail()
 }
+
+func (snd *finalClearSend) GraphQL(...interface{}) IStep {
+	return snd.fail()
+}
+
+func (snd *finalClearSend) JSONRPC(...interface{}) IStep {
+	return snd.fail()
+}
+
+func (snd *finalClearSend) Message(...interface{}) IStep {
+	return snd.fail()
+}
@@ -26,6 +26,23 @@ type IClearSendBody interface {
 	//     )
 	JSON(...interface{}) IStep
 
+	// XML removes all previous Send().Body().XML() steps.
+	//
+	// If you specify an argument it will only remove the Send().Body().XML() steps matching that argument.
+	//
+	// Usage:
+	//     Clear().Send().Body().XML()                     // will remove all Send().Body().XML() steps
+	//     Clear().Send().Body().XML(User{Name: "Joe"})    // will remove all Send().Body().XML(User{Name: "Joe"}) steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Send().Body().XML(User{Name: "Joe"}),
+	//         Clear().Send().Body().XML(),
+	//         Send().Body().XML(User{Name: "Alice"}),
+	//     )
+	XML(...interface{}) IStep
+
 	// Interface removes all previous Send().Body().Interface() steps.
 	//
 	// If you specify an argument it will only remove the Send().Body().Interface() steps matching that argument.
@@ -42,6 +59,20 @@ type IClearSendBody interface {
 	//         Send().Body().Interface("Hello World"),
 	//     )
 	Interface(...interface{}) IStep
+
+	// Gzip removes all previous Send().Body().Gzip() steps.
+	//
+	// Usage:
+	//     Clear().Send().Body().Gzip() // will remove all Send().Body().Gzip() steps
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+	//         Send().Body().Gzip(),
+	//         Clear().Send().Body().Gzip(),
+	//     )
+	Gzip(...interface{}) IStep
 }
 
 type clearSendBody struct {
@@ -84,10 +115,18 @@ func (body *clearSendBody) JSON(data ...interface{}) IStep {
 	return removeStep(body.clearPath().Push("JSON", data))
 }
 
+func (body *clearSendBody) XML(data ...interface{}) IStep {
+	return removeStep(body.clearPath().Push("XML", data))
+}
+
 func (body *clearSendBody) Interface(data ...interface{}) IStep {
 	return removeStep(body.clearPath().Push("Interface", data))
 }
 
+func (body *clearSendBody) Gzip(value ...interface{}) IStep {
+	return removeStep(body.clearPath().Push("Gzip", value))
+}
+
 type finalClearSendBody struct {
 	IStep
 	message string
@@ -108,6 +147,14 @@ func (body *finalClearSendBody) JSON(...interface{}) IStep {
 	return body.fail()
 }
 
+func (body *finalClearSendBody) XML(...interface{}) IStep {
+	return body.fail()
+}
+
 func (body *finalClearSendBody) Interface(...interface{}) IStep {
 	return body.fail()
 }
+
+func (body *finalClearSendBody) Gzip(...interface{}) IStep {
+	return body.fail()
+}
@@ -108,3 +108,17 @@ func TestClearSendBody_NotExistentStep(t *testing.T) {
 		PtrStr(`unable to find a step with Send().Body().JSON()`), PtrStr(`got these steps:`), PtrStr(`Send().Body("Hello World")`),
 	)
 }
+
+func TestClearSendBody_Gzip(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body("Hello World"),
+		Send().Body().Gzip(),
+		Clear().Send().Body().Gzip(),
+		Expect().Header("Content-Encoding").Empty(),
+		Expect().Body().Equal("Hello World"),
+	)
+}
@@ -171,6 +171,54 @@ func TestClearSend_Header(t *testing.T) {
 	})
 }
 
+func TestClearSend_GraphQL(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().GraphQL("{ user { name } }", nil),
+			Clear().Send().GraphQL(),
+			Expect().Body().Equal(""),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().GraphQL("{ user { name } }", nil),
+			Send().GraphQL("{ user { id } }", nil),
+			Clear().Send().GraphQL("{ user { id } }"),
+			Expect().Body().JSON().Equal("query", "{ user { name } }"),
+		)
+	})
+}
+
+func TestClearSend_JSONRPC(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().JSONRPC("sum", []int{1, 2}),
+			Clear().Send().JSONRPC(),
+			Expect().Body().Equal(""),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().JSONRPC("sum", []int{1, 2}),
+			Send().JSONRPC("sub", []int{1, 2}),
+			Clear().Send().JSONRPC("sub"),
+			Expect().Body().JSON().Equal("method", "sum"),
+		)
+	})
+}
+
 func TestClearSend_Final(t *testing.T) {
 	s := EchoServer()
 	defer s.Close()
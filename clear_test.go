@@ -1,9 +1,13 @@
 package hit_test
 
 import (
+	"bytes"
+	"net/http"
 	"testing"
 
 	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
 )
 
 func TestClearSend(t *testing.T) {
@@ -86,3 +90,357 @@ func TestClear_CombineSteps(t *testing.T) {
 		PtrStr("Not equal"), PtrStr(`expected: "Nature"`), nil, nil, nil, nil, nil,
 	)
 }
+
+func TestClear_Method(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			Get("http://%s", "invalid.invalid"),
+			Clear().Method(),
+			Post(s.URL),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, http.MethodPost, hit.Request().Method)
+			}),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Get("http://%s", "invalid.invalid"),
+			Clear().Method(http.MethodGet),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, http.MethodPost, hit.Request().Method)
+			}),
+		)
+	})
+
+	t.Run("url", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Method(http.MethodGet, "http://invalid.invalid"),
+			Get("http://%s", "invalid.invalid"),
+			Clear().Method(http.MethodGet, "http://invalid.invalid"),
+			Clear().Method(http.MethodGet, "http://%s", "invalid.invalid"),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, http.MethodPost, hit.Request().Method)
+			}),
+		)
+	})
+
+	t.Run("not existent", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body("Hello World"),
+				Clear().Method(http.MethodGet),
+			),
+			PtrStr(`unable to find a step with Method("GET") or Get()`), PtrStr(`got these steps:`), PtrStr(`Send().Body("Hello World")`),
+		)
+	})
+}
+
+func TestClear_Header(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Header("X-Foo", "Bar"),
+			Send().Header("X-Bar", "Foo"),
+			Expect().Header("X-Foo").Equal("Baz"),
+			Clear().Header(),
+			Expect().Custom(func(hit Hit) {
+				require.Empty(t, hit.Response().Header.Get("X-Foo"))
+				require.Empty(t, hit.Response().Header.Get("X-Bar"))
+			}),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Header("X-Foo", "Bar"),
+			Send().Header("X-Bar", "Foo"),
+			Expect().Header("X-Foo").Equal("Baz"),
+			Clear().Header("X-Foo"),
+			Expect().Custom(func(hit Hit) {
+				require.Empty(t, hit.Response().Header.Get("X-Foo"))
+				require.Equal(t, "Foo", hit.Response().Header.Get("X-Bar"))
+			}),
+		)
+	})
+
+	t.Run("not existent", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Clear().Header("X-Foo"),
+			),
+			PtrStr(`unable to find a step with Send().Header("X-Foo") or Expect().Header("X-Foo")`),
+		)
+	})
+}
+
+func TestClear_BaseURL(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			BaseURL("http://%s", "invalid.invalid"),
+			Clear().BaseURL(),
+			Post(s.URL),
+			Expect().Status().Equal(http.StatusOK),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		Test(t,
+			BaseURL(s.URL),
+			BaseURL("http://%s", "invalid.invalid"),
+			Clear().BaseURL("http://%s"),
+			Post("/"),
+			Expect().Status().Equal(http.StatusOK),
+		)
+	})
+}
+
+func TestClear_HTTPClient(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	client := &http.Client{
+		Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, xerrors.New("should not be used")
+		}),
+	}
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			HTTPClient(client),
+			Clear().HTTPClient(),
+			Expect().Status().Equal(http.StatusOK),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			HTTPClient(client),
+			Clear().HTTPClient(client),
+			Expect().Status().Equal(http.StatusOK),
+		)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return fn(request)
+}
+
+func TestClear_Description(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			Description("Foo"),
+			Description("Bar"),
+			Clear().Description(),
+			Post(s.URL),
+			Expect().Custom(func(hit Hit) {
+				require.Empty(t, hit.Description())
+			}),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		Test(t,
+			Description("Foo"),
+			Description("Bar"),
+			Clear().Description("Bar"),
+			Post(s.URL),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, "Foo", hit.Description())
+			}),
+		)
+	})
+}
+
+func TestClear_Debug(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		var buf bytes.Buffer
+		Test(t,
+			Post(s.URL),
+			Stdout(&buf),
+			Debug(),
+			Debug("Response"),
+			Clear().Debug(),
+		)
+		require.Empty(t, buf.String())
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		var buf bytes.Buffer
+		Test(t,
+			Post(s.URL),
+			Stdout(&buf),
+			Debug("Request"),
+			Debug("Response"),
+			Clear().Debug("Request"),
+		)
+		require.Contains(t, buf.String(), "StatusCode")
+		require.NotContains(t, buf.String(), "Method")
+	})
+}
+
+func TestClear_Custom(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("all", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Custom(BeforeSendStep, func(hit Hit) {
+				panic("should not be called")
+			}),
+			Custom(ExpectStep, func(hit Hit) {
+				panic("should not be called")
+			}),
+			Custom(ExpectStep, func(hit Hit) {
+				panic("should not be called")
+			}),
+			Clear().Custom(BeforeSendStep),
+			Clear().Custom(ExpectStep),
+		)
+	})
+
+	t.Run("specific", func(t *testing.T) {
+		ranCustomFunc := false
+		fn := func(hit Hit) {
+			panic("should not be called")
+		}
+		Test(t,
+			Post(s.URL),
+			Custom(ExpectStep, fn),
+			Custom(ExpectStep, func(hit Hit) {
+				ranCustomFunc = true
+			}),
+			Clear().Custom(ExpectStep, fn),
+		)
+		require.True(t, ranCustomFunc)
+	})
+}
+
+func TestClear_Groups(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("CombineSteps", func(t *testing.T) {
+		Test(t,
+			CombineSteps(
+				Get("http://%s", "invalid.invalid"),
+				Expect().Status().Equal(http.StatusNotFound),
+			),
+			Replace(CombineSteps()),
+			Post(s.URL),
+		)
+	})
+
+	t.Run("Name", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body("Hello World"),
+			Name("earth", Expect().Body().Equal("Hello Earth")),
+			Name("world", Expect().Body().Equal("Hello World")),
+			Replace(Name("earth")),
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body("Hello World"),
+			CombineSteps(
+				Tag("earth", Expect().Body().Equal("Hello Earth")),
+			),
+			Replace(CombineSteps()),
+		)
+	})
+}
+
+func TestReplace(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("replace", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body("Hello World"),
+			Expect().Body().Equal("Hello Earth"),
+			Expect().Status().Equal(http.StatusOK),
+			Replace(Expect().Body().Equal("Hello Earth"), Expect().Body().Equal("Hello World")),
+		)
+	})
+
+	t.Run("replace multiple", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body("Hello World"),
+				Expect().Body().Equal("Hello Earth"),
+				Replace(Expect().Body(), Expect().Body().Equal("Hello Universe"), Expect().Body().Equal("Hello Nature")),
+			),
+			PtrStr("Not equal"), PtrStr(`expected: "Hello Universe"`), nil, nil, nil, nil, nil,
+		)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			FollowRedirects(false),
+			Replace(FollowRedirects(false)),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, http.MethodPost, hit.Request().Method)
+			}),
+		)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Skip("not ready"),
+			Replace(Skip("not ready")),
+			Expect().Status().Equal(http.StatusOK),
+		)
+	})
+
+	t.Run("not existent", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Replace(Expect().Status().Equal(http.StatusNotFound)),
+			),
+			PtrStr(`unable to find a step with Expect().Status().Equal(404)`),
+		)
+	})
+
+	t.Run("not clearable", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Replace(Clear().Header()),
+			),
+			PtrStr(`unable to replace a step that is not clearable`),
+		)
+	})
+}
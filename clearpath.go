@@ -2,6 +2,7 @@ package hit
 
 import (
 	"fmt"
+	"net/http"
 
 	"strings"
 
@@ -42,6 +43,26 @@ func funcComparer(x, y func(Hit)) bool {
 	return fmt.Sprintf("%p", x) == fmt.Sprintf("%p", y)
 }
 
+func conditionComparer(x, y func(Hit) bool) bool {
+	return fmt.Sprintf("%p", x) == fmt.Sprintf("%p", y)
+}
+
+func failureHookComparer(x, y func(Hit, error)) bool {
+	return fmt.Sprintf("%p", x) == fmt.Sprintf("%p", y)
+}
+
+func stepHookComparer(x, y func(Hit, IStep, StepTime, error)) bool {
+	return fmt.Sprintf("%p", x) == fmt.Sprintf("%p", y)
+}
+
+func clientComparer(x, y *http.Client) bool {
+	return x == y
+}
+
+func requestComparer(x, y *http.Request) bool {
+	return x == y
+}
+
 func (cleanPath clearPath) Contains(needle clearPath) bool {
 	haySize := len(cleanPath)
 	needleSize := len(needle)
@@ -61,7 +82,14 @@ func (cleanPath clearPath) Contains(needle clearPath) bool {
 		if hayArgSize > needleArgSize {
 			hayArgSize = needleArgSize
 		}
-		if !cmp.Equal(cleanPath[i].Arguments[:hayArgSize], needle[i].Arguments, cmp.Comparer(funcComparer)) {
+		if !cmp.Equal(cleanPath[i].Arguments[:hayArgSize], needle[i].Arguments,
+			cmp.Comparer(funcComparer),
+			cmp.Comparer(conditionComparer),
+			cmp.Comparer(failureHookComparer),
+			cmp.Comparer(stepHookComparer),
+			cmp.Comparer(clientComparer),
+			cmp.Comparer(requestComparer),
+		) {
 			return false
 		}
 	}
@@ -0,0 +1,145 @@
+package hit
+
+import (
+	"encoding/json"
+	"mime"
+	"strings"
+	"sync"
+
+	"github.com/Eun/go-hit/internal/msgpack"
+)
+
+const (
+	// MsgPackContentType is the content type that is used for MessagePack bodies
+	MsgPackContentType = "application/msgpack"
+	// ProtobufContentType is the content type that is used for protobuf bodies
+	ProtobufContentType = "application/x-protobuf"
+)
+
+// Codec encodes and decodes bodies of a specific Content-Type.
+type Codec interface {
+	// Marshal returns the encoding of v.
+	Marshal(v interface{}) ([]byte, error)
+	// Unmarshal decodes data into v, if v is an *interface{} the codec should decode into generic values
+	// (map[string]interface{}, []interface{}, strings, numbers, bools and nil).
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// CodecEqualer can be implemented by a Codec that needs a custom equality for its values,
+// e.g. a protobuf codec that compares messages with proto.Equal.
+// Values of codecs that do not implement CodecEqualer are compared field by field, if the values contain unexported
+// fields (like generated protobuf messages do) they are compared by their encoding instead.
+type CodecEqualer interface {
+	Equal(a, b interface{}) bool
+}
+
+// CodecFuncs builds a Codec from a marshal and an unmarshal function.
+//
+// Example:
+//     RegisterCodec(ProtobufContentType, CodecFuncs(
+//         func(v interface{}) ([]byte, error) {
+//             m, ok := v.(proto.Message)
+//             if !ok {
+//                 return nil, fmt.Errorf("%T is not a proto.Message", v)
+//             }
+//             return proto.Marshal(m)
+//         },
+//         func(data []byte, v interface{}) error {
+//             m, ok := v.(proto.Message)
+//             if !ok {
+//                 return fmt.Errorf("%T is not a proto.Message", v)
+//             }
+//             return proto.Unmarshal(data, m)
+//         },
+//     ))
+func CodecFuncs(marshal func(v interface{}) ([]byte, error), unmarshal func(data []byte, v interface{}) error) Codec {
+	return codecFuncs{
+		marshal:   marshal,
+		unmarshal: unmarshal,
+	}
+}
+
+type codecFuncs struct {
+	marshal   func(v interface{}) ([]byte, error)
+	unmarshal func(data []byte, v interface{}) error
+}
+
+func (c codecFuncs) Marshal(v interface{}) ([]byte, error) {
+	return c.marshal(v)
+}
+
+func (c codecFuncs) Unmarshal(data []byte, v interface{}) error {
+	return c.unmarshal(data, v)
+}
+
+//nolint:gochecknoglobals
+var (
+	codecsMu sync.RWMutex
+	codecs   = map[string]Codec{
+		"application/json":                  CodecFuncs(json.Marshal, json.Unmarshal),
+		"text/json":                         CodecFuncs(json.Marshal, json.Unmarshal),
+		MsgPackContentType:                  CodecFuncs(msgpack.Marshal, msgpack.Unmarshal),
+		"application/x-msgpack":             CodecFuncs(msgpack.Marshal, msgpack.Unmarshal),
+		"application/yaml":                  yamlCodec{},
+		"application/x-yaml":                yamlCodec{},
+		"text/yaml":                         yamlCodec{},
+		"text/x-yaml":                       yamlCodec{},
+		"application/xml":                   xmlCodec{},
+		"text/xml":                          xmlCodec{},
+		"application/x-www-form-urlencoded": formCodec{},
+	}
+)
+
+// RegisterCodec registers a codec for the specified Content-Type, parameters of the content type are ignored.
+//
+// json, MessagePack, yaml, xml and application/x-www-form-urlencoded are supported out of the box, vendor types with
+// a structured syntax suffix (e.g. application/vnd.api+json) use the codec of the suffix if there is no codec
+// registered for them.
+// go-hit does not depend on a protobuf implementation so a protobuf codec has to be registered for
+// ProtobufContentType before Send().Body().Protobuf() and Expect().Body().Protobuf() can be used, both fail with
+// "no codec registered for application/x-protobuf" otherwise.
+//
+// Example:
+//     RegisterCodec("application/cbor", CodecFuncs(cbor.Marshal, cbor.Unmarshal))
+func RegisterCodec(contentType string, codec Codec) {
+	codecsMu.Lock()
+	codecs[normalizeContentType(contentType)] = codec
+	codecsMu.Unlock()
+}
+
+// snapshotCodecs returns a function that restores the registered codecs to the current state
+func snapshotCodecs() (restore func()) {
+	codecsMu.RLock()
+	snapshot := make(map[string]Codec, len(codecs))
+	for contentType, codec := range codecs {
+		snapshot[contentType] = codec
+	}
+	codecsMu.RUnlock()
+	return func() {
+		codecsMu.Lock()
+		codecs = snapshot
+		codecsMu.Unlock()
+	}
+}
+
+func lookupCodec(contentType string) (Codec, bool) {
+	contentType = normalizeContentType(contentType)
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	if codec, ok := codecs[contentType]; ok {
+		return codec, true
+	}
+	// use the structured syntax suffix, e.g. application/vnd.api+json
+	if i := strings.LastIndex(contentType, "+"); i >= 0 {
+		codec, ok := codecs["application/"+contentType[i+1:]]
+		return codec, ok
+	}
+	return nil, false
+}
+
+func normalizeContentType(contentType string) string {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		return mediaType
+	}
+	return strings.ToLower(strings.TrimSpace(contentType))
+}
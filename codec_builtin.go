@@ -0,0 +1,195 @@
+package hit
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/Eun/go-convert"
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v2"
+)
+
+// yamlCodec decodes yaml documents, maps are decoded as map[string]interface{} so they can be used like json objects
+type yamlCodec struct{}
+
+func (yamlCodec) Marshal(v interface{}) ([]byte, error) {
+	return yaml.Marshal(v)
+}
+
+func (yamlCodec) Unmarshal(data []byte, v interface{}) error {
+	p, ok := v.(*interface{})
+	if !ok {
+		return yaml.Unmarshal(data, v)
+	}
+	var container interface{}
+	if err := yaml.Unmarshal(data, &container); err != nil {
+		return err
+	}
+	*p = normalizeYAML(container)
+	return nil
+}
+
+func normalizeYAML(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for key, value := range x {
+			m[fmt.Sprint(key)] = normalizeYAML(value)
+		}
+		return m
+	case []interface{}:
+		for i := range x {
+			x[i] = normalizeYAML(x[i])
+		}
+		return x
+	default:
+		return v
+	}
+}
+
+// xmlCodec decodes xml documents.
+//
+// If decoded into an *interface{} every element becomes a map of its child elements, attributes are prefixed with @
+// and the text is stored as #text. Elements without attributes and children are decoded as strings, repeated elements
+// as []interface{}.
+//
+// <user id="10"><name>Joe</name><role>Admin</role><role>User</role></user> is decoded to
+// {"user": {"@id": "10", "name": "Joe", "role": ["Admin", "User"]}}
+type xmlCodec struct{}
+
+func (xmlCodec) Marshal(v interface{}) ([]byte, error) {
+	return xml.Marshal(v)
+}
+
+func (xmlCodec) Unmarshal(data []byte, v interface{}) error {
+	p, ok := v.(*interface{})
+	if !ok {
+		return xml.Unmarshal(data, v)
+	}
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return xerrors.New("xml document has no root element")
+		}
+		if err != nil {
+			return err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			value, err := decodeXMLElement(d, start)
+			if err != nil {
+				return err
+			}
+			*p = map[string]interface{}{start.Name.Local: value}
+			return nil
+		}
+	}
+}
+
+func decodeXMLElement(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	m := make(map[string]interface{})
+	for _, attr := range start.Attr {
+		m["@"+attr.Name.Local] = attr.Value
+	}
+	var text strings.Builder
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			value, err := decodeXMLElement(d, t)
+			if err != nil {
+				return nil, err
+			}
+			// elements are decoded as strings or maps, so an existing slice is a repeated element
+			switch existing := m[t.Name.Local].(type) {
+			case nil:
+				m[t.Name.Local] = value
+			case []interface{}:
+				m[t.Name.Local] = append(existing, value)
+			default:
+				m[t.Name.Local] = []interface{}{existing, value}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			s := strings.TrimSpace(text.String())
+			if len(m) == 0 {
+				return s, nil
+			}
+			if s != "" {
+				m["#text"] = s
+			}
+			return m, nil
+		}
+	}
+}
+
+// formCodec decodes application/x-www-form-urlencoded bodies.
+//
+// If decoded into an *interface{} keys with one value are decoded as strings, keys with multiple values as
+// []interface{}.
+type formCodec struct{}
+
+func (formCodec) Marshal(v interface{}) ([]byte, error) {
+	values := url.Values{}
+	switch x := v.(type) {
+	case url.Values:
+		values = x
+	case map[string][]string:
+		values = x
+	case map[string]string:
+		for key, value := range x {
+			values.Set(key, value)
+		}
+	default:
+		var m map[string]interface{}
+		if err := convert.Convert(v, &m); err != nil {
+			return nil, err
+		}
+		for key, value := range m {
+			if list, ok := value.([]interface{}); ok {
+				for _, e := range list {
+					values.Add(key, fmt.Sprint(e))
+				}
+				continue
+			}
+			values.Set(key, fmt.Sprint(value))
+		}
+	}
+	return []byte(values.Encode()), nil
+}
+
+func (formCodec) Unmarshal(data []byte, v interface{}) error {
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return err
+	}
+	if p, ok := v.(*url.Values); ok {
+		*p = values
+		return nil
+	}
+	m := make(map[string]interface{}, len(values))
+	for key, list := range values {
+		if len(list) == 1 {
+			m[key] = list[0]
+			continue
+		}
+		a := make([]interface{}, len(list))
+		for i := range list {
+			a[i] = list[i]
+		}
+		m[key] = a
+	}
+	if p, ok := v.(*interface{}); ok {
+		*p = m
+		return nil
+	}
+	return convert.Convert(m, v)
+}
@@ -0,0 +1,181 @@
+package hit
+
+import (
+	"os"
+
+	"github.com/Eun/go-hit/errortrace"
+	"golang.org/x/xerrors"
+)
+
+// ErrSkipped is returned by Do() if the steps were skipped with Skip() or SkipIf(), use xerrors.Is() (or errors.Is())
+// to check for it.
+var ErrSkipped = xerrors.New("skipped")
+
+type skipError struct {
+	reason string
+}
+
+func (e skipError) Error() string {
+	if e.reason == "" {
+		return ErrSkipped.Error()
+	}
+	return ErrSkipped.Error() + ": " + e.reason
+}
+
+func (e skipError) Unwrap() error {
+	return ErrSkipped
+}
+
+func isSkipped(err error) bool {
+	return err != nil && xerrors.Is(err, ErrSkipped)
+}
+
+// skipStep stops the execution of all steps, it can run during any phase
+type skipStep struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+	reason    func() (string, bool)
+}
+
+func (*skipStep) when() StepTime {
+	return CleanStep
+}
+
+func (s *skipStep) clearPath() clearPath {
+	return s.cleanPath
+}
+
+func (s *skipStep) exec(Hit) error {
+	reason, skip := s.reason()
+	if !skip {
+		return nil
+	}
+	return skipError{reason: reason}
+}
+
+// Skip stops the execution of all steps, Test() marks the test as skipped and Do() returns ErrSkipped.
+// If Skip() is not used in If() or Unless() the request will not be sent.
+//
+// Examples:
+//     Test(t,
+//         Get("https://example.com"),
+//         Skip("example.com is not available in the ci"),
+//     )
+//
+//     Test(t,
+//         Get("https://example.com"),
+//         Expect().Custom(func(hit Hit) {
+//             if hit.Response().StatusCode == http.StatusServiceUnavailable {
+//                 hit.MustDo(Skip("example.com is in maintenance"))
+//             }
+//         }),
+//     )
+func Skip(reason string) IStep {
+	return &skipStep{
+		cleanPath: newClearPath("Skip", []interface{}{reason}),
+		trace:     ett.Prepare(),
+		reason: func() (string, bool) {
+			return reason, true
+		},
+	}
+}
+
+// SkipIf skips the steps like Skip() if the specified environment variable is set to a non empty value.
+//
+// Example:
+//     Test(t,
+//         SkipIf("SHORT"),
+//         Get("https://example.com"),
+//     )
+func SkipIf(envVar string) IStep {
+	return &skipStep{
+		cleanPath: newClearPath("SkipIf", []interface{}{envVar}),
+		trace:     ett.Prepare(),
+		reason: func() (string, bool) {
+			if os.Getenv(envVar) == "" {
+				return "", false
+			}
+			return envVar + " is set", true
+		},
+	}
+}
+
+// If runs the specified steps only if the condition is true.
+//
+// The condition is evaluated during the earliest phase of the steps, e.g. during the ExpectStep for Expect() steps,
+// so the condition can use the response. Steps that can run during any phase (e.g. Skip() or Clear()) run right after
+// the condition.
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com"),
+//         If(func(hit Hit) bool {
+//             return hit.Response().StatusCode == http.StatusTooManyRequests
+//         },
+//             Expect().Header("Retry-After").NotEqual(""),
+//         ),
+//     )
+func If(condition func(hit Hit) bool, steps ...IStep) IStep {
+	return conditionalStep(newClearPath("If", []interface{}{condition}), condition, true, steps)
+}
+
+// Unless runs the specified steps only if the condition is false, the condition is evaluated like in If().
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com"),
+//         Unless(func(hit Hit) bool {
+//             return hit.Response().StatusCode == http.StatusNoContent
+//         },
+//             Expect().Body().JSON().Equal("Name", "Joe"),
+//         ),
+//     )
+func Unless(condition func(hit Hit) bool, steps ...IStep) IStep {
+	return conditionalStep(newClearPath("Unless", []interface{}{condition}), condition, false, steps)
+}
+
+func conditionalStep(cleanPath clearPath, condition func(hit Hit) bool, want bool, steps []IStep) IStep {
+	when := conditionPhase(steps)
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      when,
+		ClearPath: cleanPath,
+		Exec: func(hit Hit) error {
+			if condition(hit) != want {
+				return nil
+			}
+			var insert []IStep
+			for _, step := range steps {
+				// steps that can run during any phase run now, the phase might be over already
+				if step.when() == CleanStep && when != CleanStep {
+					if err := step.exec(hit); err != nil {
+						return err
+					}
+					continue
+				}
+				insert = append(insert, step)
+			}
+			// the inserted steps run in their phases, the ones of the current phase right after this step
+			hit.InsertSteps(insert...)
+			return nil
+		},
+	}
+}
+
+// conditionPhase returns the earliest phase of the steps, steps that can run during any phase are ignored
+func conditionPhase(steps []IStep) StepTime {
+	var when StepTime
+	for _, step := range steps {
+		w := step.when()
+		if w == CleanStep {
+			continue
+		}
+		if when == 0 || w < when {
+			when = w
+		}
+	}
+	if when == 0 {
+		return CleanStep
+	}
+	return when
+}
@@ -0,0 +1,183 @@
+package hit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
+)
+
+type skipRecorder struct {
+	failed  bool
+	skipped []interface{}
+}
+
+func (r *skipRecorder) FailNow() {
+	r.failed = true
+}
+
+func (r *skipRecorder) Skip(args ...interface{}) {
+	r.skipped = args
+}
+
+func TestIf(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/limited", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Retry-After", "60")
+		writer.WriteHeader(http.StatusTooManyRequests)
+	})
+	mux.HandleFunc("/ok", func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("Hello World"))
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	tooManyRequests := func(hit Hit) bool {
+		return hit.Response().StatusCode == http.StatusTooManyRequests
+	}
+
+	t.Run("ExpectStep", func(t *testing.T) {
+		Test(t,
+			Get(s.URL+"/limited"),
+			If(tooManyRequests,
+				Expect().Header("Retry-After").Equal(60),
+			),
+			Unless(tooManyRequests,
+				Expect().Body("Hello World"),
+			),
+		)
+
+		Test(t,
+			Get(s.URL+"/ok"),
+			If(tooManyRequests,
+				Expect().Header("Retry-After").Equal(60),
+			),
+			Unless(tooManyRequests,
+				Expect().Body("Hello World"),
+			),
+		)
+
+		ExpectError(t,
+			Do(
+				Get(s.URL+"/limited"),
+				If(tooManyRequests,
+					Expect().Header("Retry-After").Equal(30),
+				),
+			),
+			PtrStr("Not equal"),
+			PtrStr("expected: 30"),
+			PtrStr("actual: 60"),
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+	})
+
+	t.Run("inserted steps run in their phase", func(t *testing.T) {
+		var calls []string
+		always := func(Hit) bool {
+			return true
+		}
+		Test(t,
+			If(always,
+				Expect().Custom(func(hit Hit) {
+					calls = append(calls, "expect")
+				}),
+				Get(s.URL+"/ok"),
+				Send().Custom(func(hit Hit) {
+					calls = append(calls, "send")
+				}),
+			),
+			Expect().Body("Hello World"),
+		)
+		require.Equal(t, []string{"send", "expect"}, calls)
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		var r skipRecorder
+		Test(&r,
+			Get(s.URL+"/limited"),
+			If(tooManyRequests,
+				Skip("rate limited"),
+				Expect().Status(http.StatusOK),
+			),
+			Expect().Status(http.StatusOK),
+		)
+		require.False(t, r.failed)
+		require.Equal(t, []interface{}{"skipped: rate limited"}, r.skipped)
+	})
+}
+
+func TestSkip(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("Do", func(t *testing.T) {
+		sent := false
+		err := Do(
+			Get(s.URL),
+			Send().Custom(func(hit Hit) {
+				sent = true
+			}),
+			Skip("not now"),
+		)
+		require.True(t, xerrors.Is(err, ErrSkipped))
+		require.EqualError(t, err, "skipped: not now")
+		require.False(t, sent)
+	})
+
+	t.Run("Test", func(t *testing.T) {
+		var r skipRecorder
+		Test(&r,
+			Get(s.URL),
+			Skip("not now"),
+		)
+		require.False(t, r.failed)
+		require.Equal(t, []interface{}{"skipped: not now"}, r.skipped)
+	})
+
+	t.Run("MustDo in a step", func(t *testing.T) {
+		err := Do(
+			Get(s.URL),
+			SoftAssertions(),
+			Expect().Custom(func(hit Hit) {
+				hit.MustDo(Skip("after the response"))
+			}),
+			Expect().Status(http.StatusNotFound),
+		)
+		require.True(t, xerrors.Is(err, ErrSkipped))
+	})
+
+	t.Run("OnFailure is not called", func(t *testing.T) {
+		err := Do(
+			Get(s.URL),
+			Skip(""),
+			OnFailure(func(hit Hit, err error) {
+				t.Error("OnFailure should not be called")
+			}),
+		)
+		require.EqualError(t, err, "skipped")
+	})
+
+	t.Run("SkipIf", func(t *testing.T) {
+		const env = "HIT_TEST_SKIP_IF"
+		require.NoError(t, os.Unsetenv(env))
+		Test(t,
+			Get(s.URL),
+			SkipIf(env),
+		)
+
+		require.NoError(t, os.Setenv(env, "1"))
+		defer os.Unsetenv(env)
+		err := Do(
+			Get(s.URL),
+			SkipIf(env),
+		)
+		require.EqualError(t, err, "skipped: "+env+" is set")
+	})
+}
@@ -5,19 +5,23 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/Eun/go-hit/errortrace"
 	"github.com/Eun/go-hit/expr"
 	"github.com/Eun/go-hit/internal"
 	"github.com/gookit/color"
 	"github.com/tidwall/pretty"
+	"golang.org/x/xerrors"
 )
 
 type debug struct {
 	expression []string
+	trace      *errortrace.ErrorTrace
 }
 
 func newDebug(expression []string) IStep {
 	return &debug{
 		expression: expression,
+		trace:      ett.Prepare(),
 	}
 }
 
@@ -26,19 +30,39 @@ func (*debug) when() StepTime {
 }
 
 func (*debug) getBody(body *HTTPBody) interface{} {
-	reader := body.JSON().body.Reader()
-	// if there is a json reader
-	if reader != nil {
-		var container interface{}
-		if err := json.NewDecoder(reader).Decode(&container); err == nil {
+	buf, err := body.tryDecodedBytes()
+	if err != nil {
+		// the body could not be decoded, show it in its wire form
+		buf = body.RawBytes()
+	}
+
+	// if there is a codec for the content type
+	if codec, ok := lookupCodec(body.contentType()); ok {
+		if container, err := unmarshalGeneric(codec, buf); err == nil {
 			return container
 		}
 	}
-	s := body.String()
-	if len(s) == 0 {
+	// if it is json
+	var container interface{}
+	if err := json.Unmarshal(buf, &container); err == nil {
+		return container
+	}
+	if len(buf) == 0 {
 		return nil
 	}
-	return s
+	return string(buf)
+}
+
+// unmarshalGeneric decodes buf into generic values, a codec that cannot decode into an *interface{} (and panics)
+// must not break the debug output
+func unmarshalGeneric(codec Codec, buf []byte) (container interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = xerrors.Errorf("unable to decode body: %v", r)
+		}
+	}()
+	err = codec.Unmarshal(buf, &container)
+	return container, err
 }
 
 func (*debug) getHeader(header http.Header) map[string]interface{} {
@@ -55,6 +79,9 @@ func (d *debug) exec(hit Hit) error {
 	m := M{
 		"Time": time.Now().String(),
 	}
+	if description := hit.Description(); description != "" {
+		m["Description"] = description
+	}
 
 	if hit.Request() != nil {
 		m["Request"] = M{
@@ -78,6 +105,8 @@ func (d *debug) exec(hit Hit) error {
 	}
 
 	if hit.Response() != nil {
+		// the trailer is only available after the body was read
+		hit.Response().body.RawBytes()
 		m["Response"] = M{
 			"Header":           d.getHeader(hit.Response().Header),
 			"Trailer":          d.getHeader(hit.Response().Trailer),
@@ -109,6 +138,10 @@ func (d *debug) exec(hit Hit) error {
 	return err
 }
 
-func (*debug) clearPath() clearPath {
-	return nil // not clearable
+func (d *debug) clearPath() clearPath {
+	args := make([]interface{}, len(d.expression))
+	for i := range d.expression {
+		args[i] = d.expression[i]
+	}
+	return newClearPath("Debug", args)
 }
@@ -52,6 +52,27 @@ func Test_Debug(t *testing.T) {
 		require.NotNil(t, expr.MustGetValue(m, "Response"))
 	})
 
+	t.Run("codec decode", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+
+		Test(t,
+			Post(s.URL),
+			Stdout(buf),
+			Send().Header("Content-Type", "application/x-yaml"),
+			Send("Name: Joe\nRoles: [Admin, User]\n"),
+			Debug(),
+		)
+
+		var m map[string]interface{}
+		require.NoError(t, json.NewDecoder(vtclean.NewReader(buf, false)).Decode(&m))
+
+		require.Equal(t, map[string]interface{}{
+			"Name":  "Joe",
+			"Roles": []interface{}{"Admin", "User"},
+		}, expr.MustGetValue(m, "Request.Body"))
+		require.Equal(t, "Joe", expr.MustGetValue(m, "Response.Body.Name"))
+	})
+
 	t.Run("debug without body", func(t *testing.T) {
 		buf := bytes.NewBuffer(nil)
 
@@ -158,6 +158,46 @@ func (et *ErrorTrace) formatStack(calls []Call) string {
 	return sb.String()
 }
 
+// Call returns the first call of the collected trace (from the Prepare call) that is not ignored, this is the location
+// the trace was prepared at.
+// Unlike the error trace it matches the ignored names on package boundaries, so the location can be in a package
+// that only starts with the name of an ignored package (e.g. the _test package of an ignored package).
+func (et *ErrorTrace) Call() (Call, bool) {
+	if !et.inheritedTrace {
+		return Call{}, false
+	}
+	calls := resolveTraceCalls(et.inheritedPC)
+nextCall:
+	for i := range calls {
+		if calls[i].FunctionName == "" {
+			continue
+		}
+		for _, f := range et.template.ignore {
+			if isInIgnored(calls[i].FullName, f) {
+				continue nextCall
+			}
+		}
+		return calls[i], true
+	}
+	return Call{}, false
+}
+
+// isInIgnored reports whether the function name is the ignored name or a part of it, e.g. a function of an ignored
+// package, other packages that start with the same name (e.g. the _test package) are not part of it
+func isInIgnored(name, ignore string) bool {
+	if !strings.HasPrefix(name, ignore) {
+		return false
+	}
+	if len(name) == len(ignore) {
+		return true
+	}
+	switch name[len(ignore)] {
+	case '.', '/':
+		return true
+	}
+	return false
+}
+
 // Format generates an ErrorTraceError on the current position + the parent trace (from the Prepare call)
 func (et *ErrorTrace) Format(description, errText string) ErrorTraceError {
 	// collect the current trace
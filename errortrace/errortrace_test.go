@@ -2,6 +2,7 @@ package errortrace
 
 import (
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -120,6 +121,55 @@ func TestCall_FullName(t *testing.T) {
 	})
 }
 
+func TestIsInIgnored(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Ignore   string
+		Expected bool
+	}{
+		{"github.com/Eun/go-hit", "github.com/Eun/go-hit", true},
+		{"github.com/Eun/go-hit.Do", "github.com/Eun/go-hit", true},
+		{"github.com/Eun/go-hit/errortrace.New", "github.com/Eun/go-hit", true},
+		{"github.com/Eun/go-hit_test.TestDo", "github.com/Eun/go-hit", false},
+		{"testing.tRunner", "testing", true},
+		{"testingx.Run", "testing", false},
+	}
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.Name, func(t *testing.T) {
+			require.Equal(t, test.Expected, isInIgnored(test.Name, test.Ignore))
+		})
+	}
+}
+
+// prepare prepares a trace like a step constructor would, the constructor itself is not part of the trace
+func prepare(template *ErrorTraceTemplate) *ErrorTrace {
+	return template.Prepare()
+}
+
+func TestErrorTrace_Call(t *testing.T) {
+	t.Run("prepared", func(t *testing.T) {
+		et := prepare(New("runtime", "testing"))
+		call, ok := et.Call()
+		require.True(t, ok)
+		require.Equal(t, "github.com/Eun/go-hit/errortrace.TestErrorTrace_Call.func1", call.FullName)
+		require.True(t, strings.HasSuffix(call.File, "errortrace_test.go"))
+	})
+
+	t.Run("package with the prefix of an ignored package", func(t *testing.T) {
+		et := prepare(New("runtime", "testing", "github.com/Eun/go-hit/errortrac"))
+		call, ok := et.Call()
+		require.True(t, ok)
+		require.Equal(t, "github.com/Eun/go-hit/errortrace.TestErrorTrace_Call.func2", call.FullName)
+	})
+
+	t.Run("everything ignored", func(t *testing.T) {
+		et := prepare(New("runtime", "testing", "github.com/Eun/go-hit/errortrace"))
+		_, ok := et.Call()
+		require.False(t, ok)
+	})
+}
+
 // func TestErrorTrace(t *testing.T) {
 // 	t.Run("", func(t *testing.T) {
 // 		tm, err := New(0)
@@ -54,6 +54,34 @@ type IExpect interface {
 	//     )
 	Header(headerName ...string) IExpectHeader
 
+	// Trailer provides assertions to the response trailer, the body is read to receive the trailer.
+	//
+	// If you omit the argument you can fine tune the assertions.
+	//
+	// Usage:
+	//     Expect().Trailer().Contains("Grpc-Status")
+	//     Expect().Trailer("Grpc-Status").Equal(0)
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Expect().Trailer("Grpc-Status").Equal(0),
+	//     )
+	Trailer(trailerName ...string) IExpectHeader
+
+	// Proto provides assertions on the protocol of the response.
+	//
+	// Usage:
+	//     Expect().Proto().Equal("HTTP/2.0")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Protocol(HTTP2),
+	//         Expect().Proto().Equal("HTTP/2.0"),
+	//     )
+	Proto() IExpectProto
+
 	// Status expects the status to be the specified code.
 	//
 	// If you omit the argument you can fine tune the assertions.
@@ -69,6 +97,115 @@ type IExpect interface {
 	//     )
 	Status(code ...int) IExpectStatus
 
+	// SSE provides assertions on a server-sent event stream (text/event-stream).
+	//
+	// Usage:
+	//     Expect().SSE().Next().Data().Equal("Hello World")
+	//     Expect().SSE().Next().Data().JSON().Equal("Name", "Joe")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/events"),
+	//         Expect().SSE().Timeout(time.Second),
+	//         Expect().SSE().Next().Event().Equal("user"),
+	//         Expect().SSE().Next().Data().JSON().Equal("Name", "Joe"),
+	//     )
+	SSE() IExpectSSE
+
+	// Message provides assertions on the messages of the websocket connection that was established by WebSocket().
+	//
+	// If you specify an argument the next message is expected to be equal to it.
+	//
+	// Usage:
+	//     Expect().Message("Hello World")
+	//     Expect().Message().JSON().Equal("Name", "Joe")
+	//     Expect().Message().Closed(1000)
+	//
+	// Example:
+	//     MustDo(
+	//         WebSocket("ws://example.com/chat"),
+	//         Send().Message("Hello World"),
+	//         Expect().Message().Equal("Hello Joe"),
+	//     )
+	Message(value ...interface{}) IExpectMessage
+
+	// GraphQL provides assertions on a GraphQL response.
+	//
+	// Usage:
+	//     Expect().GraphQL().NoErrors()
+	//     Expect().GraphQL().Error().Contains("not found")
+	//     Expect().GraphQL().Data("user.name").Equal("Joe")
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/graphql"),
+	//         Send().GraphQL("query User($id: ID!) { user(id: $id) { name } }", map[string]interface{}{"id": 1}),
+	//         Expect().GraphQL().NoErrors(),
+	//         Expect().GraphQL().Data("user.name").Equal("Joe"),
+	//     )
+	GraphQL() IExpectGraphQL
+
+	// JSONRPC provides assertions on a JSON-RPC 2.0 response.
+	//
+	// For batch requests specify the index of the call (in the order of the calls in the request body),
+	// the response will be matched by the id that was sent for the call, so custom and string ids of bodies that were
+	// not built with Send().JSONRPC() work as well. If you omit the argument the first call is used.
+	//
+	// Usage:
+	//     Expect().JSONRPC().Result("name").Equal("Joe")
+	//     Expect().JSONRPC(1).Error().Code(-32601)
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/rpc"),
+	//         Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+	//         Send().JSONRPC("user.delete", map[string]interface{}{"id": 1}),
+	//         Expect().JSONRPC(0).Result("name").Equal("Joe"),
+	//         Expect().JSONRPC(1).Error().Code(-32601),
+	//     )
+	JSONRPC(call ...int) IExpectJSONRPC
+
+	// Redirect provides assertions on the last redirect, omit the chain to expect that there was a redirect.
+	//
+	// Usage:
+	//     Expect().Redirect()
+	//     Expect().Redirect().To("/login")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/admin"),
+	//         FollowRedirects(false),
+	//         Expect().Redirect().To("/login"),
+	//     )
+	Redirect() IExpectRedirect
+
+	// Redirects provides assertions on the redirects that were followed.
+	//
+	// Usage:
+	//     Expect().Redirects().Len(2)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Redirects().Len(2),
+	//     )
+	Redirects() IExpectRedirects
+
+	// TLS provides assertions on the tls connection, omit the chain to expect that the response was received over
+	// tls.
+	//
+	// Usage:
+	//     Expect().TLS()
+	//     Expect().TLS().Version(tls.VersionTLS13)
+	//     Expect().TLS().PeerCertificate().CommonName("example.com")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().TLS().PeerCertificate().ExpiresAfter(30 * 24 * time.Hour),
+	//     )
+	TLS() IExpectTLS
+
 	// Custom can be used to expect a custom behaviour.
 	//
 	// Example:
@@ -143,7 +280,20 @@ func (exp *expect) Header(headerName ...string) IExpectHeader {
 		args[i] = headerName[i]
 	}
 
-	return newExpectHeader(exp, exp.clearPath().Push("Header", args), headerName...)
+	return newExpectHeader(exp, exp.clearPath().Push("Header", args), responseHeader, headerName...)
+}
+
+func (exp *expect) Trailer(trailerName ...string) IExpectHeader {
+	args := make([]interface{}, len(trailerName))
+	for i := range trailerName {
+		args[i] = trailerName[i]
+	}
+
+	return newExpectHeader(exp, exp.clearPath().Push("Trailer", args), responseTrailer, trailerName...)
+}
+
+func (exp *expect) Proto() IExpectProto {
+	return newExpectProto(exp, exp.clearPath().Push("Proto", nil))
 }
 
 func (exp *expect) Status(code ...int) IExpectStatus {
@@ -154,6 +304,38 @@ func (exp *expect) Status(code ...int) IExpectStatus {
 	return newExpectStatus(exp, exp.clearPath().Push("Status", args), code)
 }
 
+func (exp *expect) SSE() IExpectSSE {
+	return newExpectSSE(exp, exp.clearPath().Push("SSE", nil))
+}
+
+func (exp *expect) Message(value ...interface{}) IExpectMessage {
+	return newExpectMessage(exp, exp.clearPath().Push("Message", value), value)
+}
+
+func (exp *expect) GraphQL() IExpectGraphQL {
+	return newExpectGraphQL(exp, exp.clearPath().Push("GraphQL", nil))
+}
+
+func (exp *expect) JSONRPC(call ...int) IExpectJSONRPC {
+	args := make([]interface{}, len(call))
+	for i := range call {
+		args[i] = call[i]
+	}
+	return newExpectJSONRPC(exp, exp.clearPath().Push("JSONRPC", args), call)
+}
+
+func (exp *expect) Redirect() IExpectRedirect {
+	return newExpectRedirect(exp, exp.clearPath().Push("Redirect", nil))
+}
+
+func (exp *expect) Redirects() IExpectRedirects {
+	return newExpectRedirects(exp, exp.clearPath().Push("Redirects", nil))
+}
+
+func (exp *expect) TLS() IExpectTLS {
+	return newExpectTLS(exp, exp.clearPath().Push("TLS", nil))
+}
+
 func (exp *expect) Interface(value interface{}) IStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
@@ -201,6 +383,20 @@ func (exp *finalExpect) Header(...string) IExpectHeader {
 	}
 }
 
+func (exp *finalExpect) Trailer(...string) IExpectHeader {
+	return &finalExpectHeader{
+		exp.fail(),
+		exp.message,
+	}
+}
+
+func (exp *finalExpect) Proto() IExpectProto {
+	return &finalExpectProto{
+		exp.fail(),
+		exp.message,
+	}
+}
+
 func (exp *finalExpect) Status(...int) IExpectStatus {
 	return &finalExpectStatus{
 		exp.fail(),
@@ -208,6 +404,55 @@ func (exp *finalExpect) Status(...int) IExpectStatus {
 	}
 }
 
+func (exp *finalExpect) SSE() IExpectSSE {
+	return &finalExpectSSE{
+		exp.fail(),
+		exp.message,
+	}
+}
+
+func (exp *finalExpect) Message(...interface{}) IExpectMessage {
+	return &finalExpectMessage{
+		exp.fail(),
+		exp.message,
+	}
+}
+
+func (exp *finalExpect) GraphQL() IExpectGraphQL {
+	return &finalExpectGraphQL{
+		exp.fail(),
+		exp.message,
+	}
+}
+
+func (exp *finalExpect) JSONRPC(...int) IExpectJSONRPC {
+	return &finalExpectJSONRPC{
+		exp.fail(),
+		exp.message,
+	}
+}
+
+func (exp *finalExpect) Redirect() IExpectRedirect {
+	return &finalExpectRedirect{
+		exp.fail(),
+		exp.message,
+	}
+}
+
+func (exp *finalExpect) Redirects() IExpectRedirects {
+	return &finalExpectRedirects{
+		exp.fail(),
+		exp.message,
+	}
+}
+
+func (exp *finalExpect) TLS() IExpectTLS {
+	return &finalExpectTLS{
+		exp.fail(),
+		exp.message,
+	}
+}
+
 func makeCompareable(in, data interface{}) (interface{}, error) {
 	compareData := deepcopy.Copy(data)
 	err := converter.Convert(in, &compareData)
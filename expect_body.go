@@ -38,6 +38,100 @@ type IExpectBody interface {
 	//     )
 	JSON(value ...interface{}) IExpectBodyJSON
 
+	// XML expects the body to be equal the specified value.
+	//
+	// If you omit the argument you can fine tune the assertions.
+	//
+	// Usage:
+	//           Expect().Body().XML(User{Name: "Joe"})
+	//           Expect().Body().XML().Equal("/user/name", "Joe")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().XML().Equal("/user/name", "Joe"),
+	//     )
+	XML(value ...interface{}) IExpectBodyXML
+
+	// HTML parses the body as a html document, use the chained functions to run assertions on it.
+	//
+	// Usage:
+	//           Expect().Body().HTML().Select("form#login input[name=csrf]").Exists()
+	//           Expect().Body().HTML().Select("form#login input[name=csrf]").Attr("value").NotEmpty()
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/login"),
+	//         Expect().Body().HTML().Select("form#login input[name=csrf]").Attr("value").NotEmpty(),
+	//     )
+	HTML() IExpectBodyHTML
+
+	// NDJSON parses the body as newline delimited json (also known as json lines), use the chained functions to run
+	// assertions on it.
+	//
+	// Usage:
+	//           Expect().Body().NDJSON().Len(3)
+	//           Expect().Body().NDJSON().Line(0).Equal("Name", "Joe")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/export"),
+	//         Expect().Body().NDJSON().Len(3),
+	//         Expect().Body().NDJSON().Line(0).Equal("Name", "Joe"),
+	//     )
+	NDJSON() IExpectBodyNDJSON
+
+	// MsgPack decodes the body as MessagePack, the chained functions work like the ones of JSON().
+	//
+	// Usage:
+	//           Expect().Body().MsgPack(map[string]interface{}{"Name": "Joe"})
+	//           Expect().Body().MsgPack().Equal("Name", "Joe")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().MsgPack().Equal("Name", "Joe"),
+	//     )
+	MsgPack(value ...interface{}) IExpectBodyMsgPack
+
+	// Decoded decodes the body with the codec that is registered for the Content-Type of the response, the chained
+	// functions work like the ones of JSON().
+	//
+	// json, MessagePack, yaml, xml and application/x-www-form-urlencoded are supported out of the box, use
+	// RegisterCodec to add other formats.
+	//
+	// Usage:
+	//           Expect().Body().Decoded().Equal("Name", "Joe")
+	//           Expect().Body().Decoded().Len("Roles", 2)
+	//
+	// Example:
+	//     // given the following yaml response with the Content-Type application/x-yaml:
+	//     // Name: Joe
+	//     // Roles: [Admin, User]
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().Decoded().Equal("Name", "Joe"),
+	//         Expect().Body().Decoded().Contains("Roles", "Admin"),
+	//     )
+	Decoded() IExpectBodyDecoded
+
+	// Protobuf decodes the body into a new message of the type of the specified message and expects it to be equal to
+	// the specified message.
+	//
+	// go-hit does not ship a protobuf implementation, the step fails until a codec for ProtobufContentType is registered
+	// with RegisterCodec. If the codec implements CodecEqualer (e.g. with proto.Equal) it is used to compare the
+	// messages.
+	//
+	// Usage:
+	//           Expect().Body().Protobuf(&pb.User{Name: "Joe"})
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().Protobuf(&pb.User{Name: "Joe"}),
+	//     )
+	Protobuf(message interface{}) IStep
+
 	// Equal expects the body to be equal to the specified value
 	//
 	// Usage:
@@ -85,6 +179,39 @@ type IExpectBody interface {
 	//         Expect().Body().NotContains("Hello World"),
 	//     )
 	NotContains(value interface{}) IStep
+
+	// Matches expects the body to match the specified regular expression, the pattern can be a string or a
+	// *regexp.Regexp.
+	//
+	// Named capture groups are stored and can be accessed in later steps with hit.Var(name).
+	//
+	// Usage:
+	//     Expect().Body().Matches(`Hello \w+`)
+	//     Expect().Body().Matches(regexp.MustCompile(`(?i)hello`))
+	//     Expect().Body().Matches(`Your order (?P<order>\d+)`)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().Matches(`Your order (?P<order>\d+)`),
+	//         Expect().Custom(func(hit Hit) {
+	//             fmt.Println(hit.Var("order"))
+	//         }),
+	//     )
+	Matches(pattern interface{}) IStep
+
+	// NotMatches expects the body to not match the specified regular expression, the pattern can be a string or a
+	// *regexp.Regexp.
+	//
+	// Usage:
+	//     Expect().Body().NotMatches(`(?i)error`)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().NotMatches(`(?i)error`),
+	//     )
+	NotMatches(pattern interface{}) IStep
 }
 
 type expectBody struct {
@@ -132,6 +259,38 @@ func (body *expectBody) JSON(value ...interface{}) IExpectBodyJSON {
 	return newExpectBodyJSON(body, body.clearPath().Push("JSON", value), value)
 }
 
+func (body *expectBody) XML(value ...interface{}) IExpectBodyXML {
+	return newExpectBodyXML(body, body.clearPath().Push("XML", value), value)
+}
+
+func (body *expectBody) HTML() IExpectBodyHTML {
+	return newExpectBodyHTML(body, body.clearPath().Push("HTML", nil))
+}
+
+func (body *expectBody) NDJSON() IExpectBodyNDJSON {
+	return newExpectBodyNDJSON(body, body.clearPath().Push("NDJSON", nil))
+}
+
+func (body *expectBody) MsgPack(value ...interface{}) IExpectBodyMsgPack {
+	return newExpectBodyMsgPack(body.clearPath().Push("MsgPack", value), value)
+}
+
+func (body *expectBody) Decoded() IExpectBodyDecoded {
+	return newExpectBodyDecoded(body.clearPath().Push("Decoded", nil))
+}
+
+func (body *expectBody) Protobuf(message interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: body.clearPath().Push("Protobuf", []interface{}{message}),
+		Exec: func(hit Hit) error {
+			expectCodecEqual(hit.Response().Body().Protobuf(), message)
+			return nil
+		},
+	}
+}
+
 func (body *expectBody) Interface(value interface{}) IStep {
 	switch x := value.(type) {
 	case func(e Hit):
@@ -228,6 +387,28 @@ func (body *expectBody) NotContains(value interface{}) IStep {
 	}
 }
 
+func (body *expectBody) Matches(pattern interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: body.clearPath().Push("Matches", []interface{}{patternArgument(pattern)}),
+		Exec: func(hit Hit) error {
+			return expectMatch(hit, pattern, hit.Response().body.String())
+		},
+	}
+}
+
+func (body *expectBody) NotMatches(pattern interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: body.clearPath().Push("NotMatches", []interface{}{patternArgument(pattern)}),
+		Exec: func(hit Hit) error {
+			return expectNoMatch(pattern, hit.Response().body.String())
+		},
+	}
+}
+
 type finalExpectBody struct {
 	IStep
 	message string
@@ -250,6 +431,39 @@ func (body *finalExpectBody) JSON(...interface{}) IExpectBodyJSON {
 		body.message,
 	}
 }
+func (body *finalExpectBody) XML(...interface{}) IExpectBodyXML {
+	return &finalExpectBodyXML{
+		body.fail(),
+		body.message,
+	}
+}
+func (body *finalExpectBody) HTML() IExpectBodyHTML {
+	return &finalExpectBodyHTML{
+		body.fail(),
+		body.message,
+	}
+}
+func (body *finalExpectBody) NDJSON() IExpectBodyNDJSON {
+	return &finalExpectBodyNDJSON{
+		body.fail(),
+		body.message,
+	}
+}
+func (body *finalExpectBody) MsgPack(...interface{}) IExpectBodyMsgPack {
+	return &finalExpectJSONData{
+		body.fail(),
+		body.message,
+	}
+}
+func (body *finalExpectBody) Decoded() IExpectBodyDecoded {
+	return &finalExpectJSONData{
+		body.fail(),
+		body.message,
+	}
+}
+func (body *finalExpectBody) Protobuf(interface{}) IStep {
+	return body.fail()
+}
 func (body *finalExpectBody) Interface(interface{}) IStep {
 	return body.fail()
 }
@@ -265,3 +479,9 @@ func (body *finalExpectBody) Contains(interface{}) IStep {
 func (body *finalExpectBody) NotContains(interface{}) IStep {
 	return body.fail()
 }
+func (body *finalExpectBody) Matches(interface{}) IStep {
+	return body.fail()
+}
+func (body *finalExpectBody) NotMatches(interface{}) IStep {
+	return body.fail()
+}
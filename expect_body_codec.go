@@ -0,0 +1,196 @@
+package hit
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/Eun/go-hit/internal"
+	"github.com/Eun/go-hit/internal/minitest"
+)
+
+// IExpectBodyMsgPack provides assertions on the http response MessagePack body.
+//
+// See IExpectBodyJSON for usage and examples.
+type IExpectBodyMsgPack interface {
+	IStep
+	// Equal expects the body to be equal to the specified value.
+	Equal(expression string, data interface{}, opts ...JSONOption) IStep
+	// NotEqual expects the body to be not equal to the specified value.
+	NotEqual(expression string, data interface{}) IStep
+	// Contains expects the body to contain the specified value.
+	Contains(expression string, data interface{}) IStep
+	// NotContains expects the body to not contain the specified value.
+	NotContains(expression string, data interface{}) IStep
+	// Subset expects the body to contain all object keys and values of the specified value.
+	Subset(expression string, data interface{}, opts ...JSONOption) IStep
+	// Len expects the string, array or object to have the specified length.
+	Len(expression string, size int) IStep
+	// Exists expects the value to exist.
+	Exists(expression string) IStep
+	// NotExists expects the value to not exist.
+	NotExists(expression string) IStep
+	// Matches expects the value to match the specified regular expression.
+	Matches(expression string, pattern interface{}) IStep
+	// NotMatches expects the value to not match the specified regular expression.
+	NotMatches(expression string, pattern interface{}) IStep
+	// Type expects the value to be of the specified type (string, number, boolean, object, array or null).
+	Type(expression string, typ string) IStep
+	// GreaterThan expects the value to be greater than the specified value.
+	GreaterThan(expression string, data interface{}) IStep
+	// LessThan expects the value to be less than the specified value.
+	LessThan(expression string, data interface{}) IStep
+	// GreaterOrEqualThan expects the value to be greater or equal than the specified value.
+	GreaterOrEqualThan(expression string, data interface{}) IStep
+	// LessOrEqualThan expects the value to be less or equal than the specified value.
+	LessOrEqualThan(expression string, data interface{}) IStep
+	// Between expects the value to be between min and max (inclusive).
+	Between(expression string, min, max interface{}) IStep
+	// OneOf expects the value to be equal to one of the specified values.
+	OneOf(expression string, values ...interface{}) IStep
+	// Each expects all elements of the array (or all values of the object) to pass the assertions in fn.
+	Each(expression string, fn func(e IExpectJSONValue)) IStep
+	// Any expects at least one element of the array (or one value of the object) to pass the assertions in fn.
+	Any(expression string, fn func(e IExpectJSONValue)) IStep
+}
+
+// IExpectBodyDecoded provides assertions on the http response body that is decoded with the codec that is registered
+// for its Content-Type.
+//
+// See IExpectBodyJSON for usage and examples.
+type IExpectBodyDecoded interface {
+	IStep
+	// Equal expects the body to be equal to the specified value.
+	Equal(expression string, data interface{}, opts ...JSONOption) IStep
+	// NotEqual expects the body to be not equal to the specified value.
+	NotEqual(expression string, data interface{}) IStep
+	// Contains expects the body to contain the specified value.
+	Contains(expression string, data interface{}) IStep
+	// NotContains expects the body to not contain the specified value.
+	NotContains(expression string, data interface{}) IStep
+	// Subset expects the body to contain all object keys and values of the specified value.
+	Subset(expression string, data interface{}, opts ...JSONOption) IStep
+	// Len expects the string, array or object to have the specified length.
+	Len(expression string, size int) IStep
+	// Exists expects the value to exist.
+	Exists(expression string) IStep
+	// NotExists expects the value to not exist.
+	NotExists(expression string) IStep
+	// Matches expects the value to match the specified regular expression.
+	Matches(expression string, pattern interface{}) IStep
+	// NotMatches expects the value to not match the specified regular expression.
+	NotMatches(expression string, pattern interface{}) IStep
+	// Type expects the value to be of the specified type (string, number, boolean, object, array or null).
+	Type(expression string, typ string) IStep
+	// GreaterThan expects the value to be greater than the specified value.
+	GreaterThan(expression string, data interface{}) IStep
+	// LessThan expects the value to be less than the specified value.
+	LessThan(expression string, data interface{}) IStep
+	// GreaterOrEqualThan expects the value to be greater or equal than the specified value.
+	GreaterOrEqualThan(expression string, data interface{}) IStep
+	// LessOrEqualThan expects the value to be less or equal than the specified value.
+	LessOrEqualThan(expression string, data interface{}) IStep
+	// Between expects the value to be between min and max (inclusive).
+	Between(expression string, min, max interface{}) IStep
+	// OneOf expects the value to be equal to one of the specified values.
+	OneOf(expression string, values ...interface{}) IStep
+	// Each expects all elements of the array (or all values of the object) to pass the assertions in fn.
+	Each(expression string, fn func(e IExpectJSONValue)) IStep
+	// Any expects at least one element of the array (or one value of the object) to pass the assertions in fn.
+	Any(expression string, fn func(e IExpectJSONValue)) IStep
+}
+
+func newExpectBodyDecoded(cleanPath clearPath) IExpectBodyDecoded {
+	return newExpectDecodedData(
+		cleanPath,
+		"Expect().Body().Decoded()",
+		func(hit Hit) (interface{}, error) {
+			return hit.Response().Body().Decoded().Get(""), nil
+		},
+	)
+}
+
+func newExpectBodyMsgPack(cleanPath clearPath, params []interface{}) IExpectBodyMsgPack {
+	mp := newExpectDecodedData(
+		cleanPath,
+		"Expect().Body().MsgPack()",
+		func(hit Hit) (interface{}, error) {
+			return hit.Response().Body().MsgPack().Get(""), nil
+		},
+	)
+
+	if param, ok := internal.GetLastArgument(params); ok {
+		return &finalExpectJSONData{
+			&hitStep{
+				Trace:     mp.trace,
+				When:      ExpectStep,
+				ClearPath: cleanPath,
+				Exec:      mp.Equal("", param).exec,
+			},
+			"only usable with Expect().Body().MsgPack() not with Expect().Body().MsgPack(value)",
+		}
+	}
+	return mp
+}
+
+// expectCodecEqual decodes the body into a new value of the type of expected and compares both,
+// the codec is used for the comparison if it implements CodecEqualer
+func expectCodecEqual(body *HTTPCodec, expected interface{}) {
+	if expected == nil {
+		minitest.Errorf("unable to decode body into nil")
+	}
+	typ := reflect.TypeOf(expected)
+	var actual interface{}
+	if typ.Kind() == reflect.Ptr {
+		v := reflect.New(typ.Elem())
+		body.Decode(v.Interface())
+		actual = v.Interface()
+	} else {
+		v := reflect.New(typ)
+		body.Decode(v.Interface())
+		actual = v.Elem().Interface()
+	}
+
+	codec := body.codec()
+	if eq, ok := codec.(CodecEqualer); ok {
+		if !eq.Equal(expected, actual) {
+			minitest.Errorf("Not equal\nexpected: %s\nactual: %s", minitest.PrintValue(expected), minitest.PrintValue(actual))
+		}
+		return
+	}
+	if !hasUnexportedFields(typ, map[reflect.Type]bool{}) {
+		minitest.Equal(expected, actual)
+		return
+	}
+
+	// values with unexported fields (e.g. generated protobuf messages) cannot be compared field by field,
+	// compare their encoding instead
+	expectedBuf, err := codec.Marshal(expected)
+	minitest.NoError(err, "unable to encode the expected value")
+	actualBuf, err := codec.Marshal(actual)
+	minitest.NoError(err, "unable to encode the body")
+	if !bytes.Equal(expectedBuf, actualBuf) {
+		minitest.Errorf("Not equal\nexpected: %s\nactual: %s", minitest.PrintValue(expected), minitest.PrintValue(actual))
+	}
+}
+
+// hasUnexportedFields reports whether values of the type contain unexported struct fields
+func hasUnexportedFields(typ reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[typ] {
+		return false
+	}
+	seen[typ] = true
+	switch typ.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return hasUnexportedFields(typ.Elem(), seen)
+	case reflect.Map:
+		return hasUnexportedFields(typ.Key(), seen) || hasUnexportedFields(typ.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if field.PkgPath != "" || hasUnexportedFields(field.Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,321 @@
+package hit_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/Eun/go-hit/internal/msgpack"
+	"golang.org/x/xerrors"
+)
+
+func TestMsgPack(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	user := map[string]interface{}{
+		"Name":  "Joe",
+		"Age":   10,
+		"Roles": []string{"Admin", "User"},
+	}
+
+	Test(t,
+		Post(s.URL),
+		Send().Body().MsgPack(user),
+		Expect().Header("Content-Type").Equal("application/msgpack"),
+		Expect().Body().MsgPack(user),
+		Expect().Body().MsgPack().Equal("Name", "Joe"),
+		Expect().Body().MsgPack().NotEqual("Name", "Alice"),
+		Expect().Body().MsgPack().Equal("Age", 10),
+		Expect().Body().MsgPack().Contains("Roles", "Admin"),
+		Expect().Body().MsgPack().NotContains("Roles", "Guest"),
+		Expect().Body().MsgPack().Subset("", map[string]interface{}{"Name": "Joe"}),
+		Expect().Body().MsgPack().Len("Roles", 2),
+		Expect().Body().MsgPack().Exists("Roles"),
+		Expect().Body().MsgPack().NotExists("Email"),
+		Expect().Body().MsgPack().Matches("Name", `^J`),
+		Expect().Body().MsgPack().NotMatches("Name", `^A`),
+		Expect().Body().MsgPack().Type("Age", "number"),
+		Expect().Body().MsgPack().GreaterThan("Age", 9),
+		Expect().Body().MsgPack().LessThan("Age", 11),
+		Expect().Body().MsgPack().GreaterOrEqualThan("Age", 10),
+		Expect().Body().MsgPack().LessOrEqualThan("Age", 10),
+		Expect().Body().MsgPack().Between("Age", 1, 10),
+		Expect().Body().MsgPack().OneOf("Name", "Alice", "Joe"),
+		Expect().Body().MsgPack().Each("Roles", func(e IExpectJSONValue) {
+			e.Type("", "string")
+		}),
+		Expect().Body().MsgPack().Any("Roles", func(e IExpectJSONValue) {
+			e.Equal("", "Admin")
+		}),
+		Expect().Body().Interface(func(hit Hit) {
+			if hit.Response().Body().MsgPack().Get("Roles.1") != "User" {
+				t.Fatal("expected Roles.1 to be User")
+			}
+		}),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body().MsgPack(user),
+			Expect().Body().MsgPack().Equal("Name", "Alice"),
+		),
+		PtrStr("Not equal"), nil, nil, nil, nil, nil, nil,
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body("Hello"),
+			Expect().Body().MsgPack().Equal("Name", "Joe"),
+		),
+		PtrStr("unable to decode body as application/msgpack"),
+		PtrStr("msgpack: 4 unexpected bytes after the value"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body().MsgPack(user),
+			Expect().Body().MsgPack().Between("Age", 11, 20),
+		),
+		PtrStr("10 is not between 11 and 20"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body().MsgPack(user),
+			Expect().Body().MsgPack(),
+		),
+		PtrStr("unable to run Expect().Body().MsgPack() without a chain. Please use Expect().Body().MsgPack().Something"),
+	)
+}
+
+// protoUser mimics a protobuf message, the cache field must not be part of the equality
+type protoUser struct {
+	Name  string
+	cache int
+}
+
+type protoCodec struct{}
+
+func (protoCodec) Marshal(v interface{}) ([]byte, error) {
+	u, ok := v.(*protoUser)
+	if !ok {
+		return nil, xerrors.Errorf("%T is not a message", v)
+	}
+	return []byte("name:" + u.Name), nil
+}
+
+func (protoCodec) Unmarshal(data []byte, v interface{}) error {
+	u, ok := v.(*protoUser)
+	if !ok {
+		return xerrors.Errorf("%T is not a message", v)
+	}
+	if !strings.HasPrefix(string(data), "name:") {
+		return xerrors.New("invalid message")
+	}
+	u.Name = strings.TrimPrefix(string(data), "name:")
+	u.cache = len(data)
+	return nil
+}
+
+func (protoCodec) Equal(a, b interface{}) bool {
+	return a.(*protoUser).Name == b.(*protoUser).Name
+}
+
+func TestProtobuf(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("no codec", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body().Protobuf(&protoUser{Name: "Joe"}),
+			),
+			PtrStr("no codec registered for application/x-protobuf, use RegisterCodec() to register one"),
+		)
+	})
+
+	defer SnapshotCodecs()()
+	RegisterCodec(ProtobufContentType, protoCodec{})
+
+	t.Run("equal", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body().Protobuf(&protoUser{Name: "Joe"}),
+			Expect().Header("Content-Type").Equal("application/x-protobuf"),
+			Expect().Body().Equal("name:Joe"),
+			Expect().Body().Protobuf(&protoUser{Name: "Joe"}),
+		)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body().Protobuf(&protoUser{Name: "Joe"}),
+				Expect().Body().Protobuf(&protoUser{Name: "Alice"}),
+			),
+			PtrStr("Not equal"), nil, nil, nil, nil, nil, nil, nil, nil,
+		)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body("Hello"),
+				Expect().Body().Protobuf(&protoUser{Name: "Joe"}),
+			),
+			PtrStr("unable to decode body as application/x-protobuf"), nil,
+		)
+	})
+
+	t.Run("without CodecEqualer", func(t *testing.T) {
+		defer SnapshotCodecs()()
+		// the codec panics for values that are not messages, like the type assertions of generated code do
+		RegisterCodec(ProtobufContentType, CodecFuncs(
+			func(v interface{}) ([]byte, error) {
+				return protoCodec{}.Marshal(v.(*protoUser))
+			},
+			func(data []byte, v interface{}) error {
+				return protoCodec{}.Unmarshal(data, v.(*protoUser))
+			},
+		))
+
+		var buf bytes.Buffer
+		Test(t,
+			Post(s.URL),
+			Send().Body().Protobuf(&protoUser{Name: "Joe"}),
+			Stdout(&buf),
+			Debug(),
+			Expect().Body().Protobuf(&protoUser{Name: "Joe"}),
+		)
+		if !strings.Contains(buf.String(), "name:Joe") {
+			t.Fatalf("expected the debug output to contain the body, got %s", buf.String())
+		}
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body().Protobuf(&protoUser{Name: "Joe"}),
+				Expect().Body().Protobuf(&protoUser{Name: "Alice"}),
+			),
+			PtrStr("Not equal"), nil, nil, nil, nil, nil, nil, nil, nil,
+		)
+	})
+}
+
+func TestRegisterCodec(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	defer SnapshotCodecs()()
+	RegisterCodec("application/vnd.test+msgpack; charset=binary", CodecFuncs(msgpack.Marshal, msgpack.Unmarshal))
+
+	Test(t,
+		Post(s.URL),
+		Send().Body().Interface(func(hit Hit) {
+			hit.Request().Body().Codec("application/vnd.test+msgpack").Set([]int{1, 2})
+		}),
+		Expect().Body().MsgPack([]int{1, 2}),
+	)
+}
+
+func TestExpectBodyDecoded(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("yaml", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Header("Content-Type", "application/x-yaml"),
+			Send().Body("user:\n  name: Joe\n  roles: [Admin, User]\n  age: 10\n"),
+			Expect().Body().Decoded().Equal("user.name", "Joe"),
+			Expect().Body().Decoded().Equal("user.age", 10),
+			Expect().Body().Decoded().Contains("user.roles", "Admin"),
+			Expect().Body().Decoded().Len("user.roles", 2),
+			Expect().Body().Decoded().Subset("user", map[string]interface{}{"name": "Joe"}),
+			Expect().Body().Decoded().NotExists("user.email"),
+		)
+	})
+
+	t.Run("xml", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Header("Content-Type", "application/xml; charset=utf-8"),
+			Send().Body(`<user id="10"><name>Joe</name><role>Admin</role><role>User</role><note lang="en">hi</note></user>`),
+			Expect().Body().Decoded().Equal("user", map[string]interface{}{
+				"@id":  "10",
+				"name": "Joe",
+				"role": []interface{}{"Admin", "User"},
+				"note": map[string]interface{}{"@lang": "en", "#text": "hi"},
+			}),
+			Expect().Body().Decoded().Equal("user.name", "Joe"),
+			Expect().Body().Decoded().Len("user.role", 2),
+		)
+	})
+
+	t.Run("form", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Header("Content-Type", "application/x-www-form-urlencoded"),
+			Send().Body("name=Joe&role=Admin&role=User"),
+			Expect().Body().Decoded().Equal("", map[string]interface{}{
+				"name": "Joe",
+				"role": []interface{}{"Admin", "User"},
+			}),
+		)
+	})
+
+	t.Run("structured syntax suffix", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Header("Content-Type", "application/vnd.api+json"),
+			Send().Body(`{"data": {"type": "users", "id": "1"}}`),
+			Expect().Body().Decoded().Equal("data.type", "users"),
+		)
+	})
+
+	t.Run("unknown content type", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Header("Content-Type", "application/octet-stream"),
+				Send().Body("Hello"),
+				Expect().Body().Decoded().Equal("", "Hello"),
+			),
+			PtrStr("no codec registered for application/octet-stream, use RegisterCodec() to register one"),
+		)
+	})
+
+	t.Run("custom codec", func(t *testing.T) {
+		defer SnapshotCodecs()()
+		RegisterCodec("text/x-lines", CodecFuncs(
+			func(v interface{}) ([]byte, error) {
+				return nil, xerrors.New("not implemented")
+			},
+			func(data []byte, v interface{}) error {
+				var lines []interface{}
+				for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+					lines = append(lines, line)
+				}
+				*v.(*interface{}) = lines
+				return nil
+			},
+		))
+
+		Test(t,
+			Post(s.URL),
+			Send().Header("Content-Type", "text/x-lines"),
+			Send().Body("Joe\nAlice\n"),
+			Expect().Body().Decoded().Equal("", []string{"Joe", "Alice"}),
+			Expect().Body().Decoded().Equal("1", "Alice"),
+		)
+	})
+}
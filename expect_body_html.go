@@ -0,0 +1,542 @@
+package hit
+
+import (
+	"strings"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal/dom"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectBodyHTML provides assertions on the http response html body
+type IExpectBodyHTML interface {
+	IStep
+	// Select selects all elements that match the css selector, use the chained functions to run assertions on them.
+	//
+	// Supported are type, id, class and attribute selectors, the descendant, child and sibling combinators,
+	// selector lists and the most common pseudo classes (e.g. :first-child, :nth-child(), :not()).
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("form#login input[name=csrf]").Exists()
+	//     Expect().Body().HTML().Select("form#login input[name=csrf]").Attr("value").NotEmpty()
+	//     Expect().Body().HTML().Select("h1").Text().Contains("Welcome")
+	//     Expect().Body().HTML().Select("ul#menu > li").Count(3)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/login"),
+	//         Expect().Body().HTML().Select("form#login input[name=csrf]").Attr("value").NotEmpty(),
+	//     )
+	Select(selector string) IExpectBodyHTMLSelection
+}
+
+// IExpectBodyHTMLSelection provides assertions on the elements that were selected with Expect().Body().HTML().Select()
+type IExpectBodyHTMLSelection interface {
+	IStep
+	// Exists expects the selector to match at least one element.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("form#login").Exists()
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/login"),
+	//         Expect().Body().HTML().Select("form#login").Exists(),
+	//     )
+	Exists() IStep
+
+	// NotExists expects the selector to match no element.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select(".error").NotExists()
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/login"),
+	//         Expect().Body().HTML().Select(".error").NotExists(),
+	//     )
+	NotExists() IStep
+
+	// Count expects the selector to match the specified amount of elements.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("ul#menu > li").Count(3)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().HTML().Select("ul#menu > li").Count(3),
+	//     )
+	Count(size int) IStep
+
+	// Text provides assertions on the text of the first element that matches the selector,
+	// leading and trailing whitespace is removed.
+	// The assertions fail if no element matches the selector.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("h1").Text().Equal("Welcome")
+	//     Expect().Body().HTML().Select("h1").Text().Contains("Welcome")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().HTML().Select("h1").Text().Contains("Welcome"),
+	//     )
+	Text() IExpectBodyHTMLValue
+
+	// Attr provides assertions on the attribute of the first element that matches the selector,
+	// a missing attribute is treated as an empty value.
+	// The assertions fail if no element matches the selector.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("input[name=csrf]").Attr("value").NotEmpty()
+	//     Expect().Body().HTML().Select("a.logout").Attr("href").Equal("/logout")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/login"),
+	//         Expect().Body().HTML().Select("input[name=csrf]").Attr("value").NotEmpty(),
+	//     )
+	Attr(name string) IExpectBodyHTMLValue
+}
+
+// IExpectBodyHTMLValue provides assertions on a text or attribute value of a html element
+type IExpectBodyHTMLValue interface {
+	IStep
+	// Equal expects the value to be equal to the specified value.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("h1").Text().Equal("Welcome")
+	//     Expect().Body().HTML().Select("#count").Text().Equal(10)
+	Equal(value interface{}) IStep
+
+	// NotEqual expects the value to be not equal to the specified value.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("h1").Text().NotEqual("Error")
+	NotEqual(value interface{}) IStep
+
+	// Contains expects the value to contain the specified value.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("h1").Text().Contains("Welcome")
+	Contains(value interface{}) IStep
+
+	// NotContains expects the value to not contain the specified value.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("h1").Text().NotContains("Error")
+	NotContains(value interface{}) IStep
+
+	// OneOf expects the value to be equal to one of the specified values.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("input[name=lang]").Attr("value").OneOf("en", "de")
+	OneOf(values ...interface{}) IStep
+
+	// NotOneOf expects the value to be not equal to one of the specified values.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("input[name=lang]").Attr("value").NotOneOf("fr", "es")
+	NotOneOf(values ...interface{}) IStep
+
+	// Empty expects the value to be empty.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select(".error").Text().Empty()
+	Empty() IStep
+
+	// NotEmpty expects the value to be not empty.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("input[name=csrf]").Attr("value").NotEmpty()
+	NotEmpty() IStep
+
+	// Len expects the value to have the specified length.
+	//
+	// Usage:
+	//     Expect().Body().HTML().Select("input[name=csrf]").Attr("value").Len(32)
+	Len(size int) IStep
+}
+
+type expectBodyHTML struct {
+	expectBody IExpectBody
+	cleanPath  clearPath
+	trace      *errortrace.ErrorTrace
+}
+
+func newExpectBodyHTML(expectBody IExpectBody, cleanPath clearPath) IExpectBodyHTML {
+	return &expectBodyHTML{
+		expectBody: expectBody,
+		cleanPath:  cleanPath,
+		trace:      ett.Prepare(),
+	}
+}
+
+func (h *expectBodyHTML) exec(hit Hit) error {
+	return h.trace.Format(hit.Description(), "unable to run Expect().Body().HTML() without a chain. Please use Expect().Body().HTML().Select(selector).Something")
+}
+
+func (*expectBodyHTML) when() StepTime {
+	return ExpectStep
+}
+
+func (h *expectBodyHTML) clearPath() clearPath {
+	return h.cleanPath
+}
+
+func (h *expectBodyHTML) Select(selector string) IExpectBodyHTMLSelection {
+	return newExpectBodyHTMLSelection(h.clearPath().Push("Select", []interface{}{selector}), selector)
+}
+
+type expectBodyHTMLSelection struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+	selector  string
+}
+
+func newExpectBodyHTMLSelection(cleanPath clearPath, selector string) IExpectBodyHTMLSelection {
+	return &expectBodyHTMLSelection{
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+		selector:  selector,
+	}
+}
+
+func (sel *expectBodyHTMLSelection) exec(hit Hit) error {
+	return sel.trace.Format(hit.Description(), "unable to run Expect().Body().HTML().Select() without a chain. Please use Expect().Body().HTML().Select(selector).Something")
+}
+
+func (*expectBodyHTMLSelection) when() StepTime {
+	return ExpectStep
+}
+
+func (sel *expectBodyHTMLSelection) clearPath() clearPath {
+	return sel.cleanPath
+}
+
+func (sel *expectBodyHTMLSelection) Exists() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: sel.clearPath().Push("Exists", nil),
+		Exec: func(hit Hit) error {
+			if hit.Response().body.HTML().Count(sel.selector) == 0 {
+				minitest.Errorf("%s does not match any element", sel.selector)
+			}
+			return nil
+		},
+	}
+}
+
+func (sel *expectBodyHTMLSelection) NotExists() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: sel.clearPath().Push("NotExists", nil),
+		Exec: func(hit Hit) error {
+			if n := hit.Response().body.HTML().Count(sel.selector); n != 0 {
+				minitest.Errorf("%s matches %d element(s)", sel.selector, n)
+			}
+			return nil
+		},
+	}
+}
+
+func (sel *expectBodyHTMLSelection) Count(size int) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: sel.clearPath().Push("Count", []interface{}{size}),
+		Exec: func(hit Hit) error {
+			if n := hit.Response().body.HTML().Count(sel.selector); n != size {
+				minitest.Errorf("%s should match %d element(s), but matches %d", sel.selector, size, n)
+			}
+			return nil
+		},
+	}
+}
+
+func (sel *expectBodyHTMLSelection) Text() IExpectBodyHTMLValue {
+	return newExpectBodyHTMLValue(sel.clearPath().Push("Text", nil), sel.selector, func(node *dom.Node) string {
+		return strings.TrimSpace(node.Value())
+	})
+}
+
+func (sel *expectBodyHTMLSelection) Attr(name string) IExpectBodyHTMLValue {
+	return newExpectBodyHTMLValue(sel.clearPath().Push("Attr", []interface{}{name}), sel.selector, func(node *dom.Node) string {
+		v, _ := node.Attribute(strings.ToLower(name))
+		return v
+	})
+}
+
+type expectBodyHTMLValue struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+	selector  string
+	getter    func(node *dom.Node) string
+}
+
+func newExpectBodyHTMLValue(cleanPath clearPath, selector string, getter func(node *dom.Node) string) IExpectBodyHTMLValue {
+	return &expectBodyHTMLValue{
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+		selector:  selector,
+		getter:    getter,
+	}
+}
+
+func (v *expectBodyHTMLValue) exec(hit Hit) error {
+	return v.trace.Format(hit.Description(), "unable to run Expect().Body().HTML().Select().Text() or Expect().Body().HTML().Select().Attr() without a chain. Please use Expect().Body().HTML().Select(selector).Text().Something")
+}
+
+func (*expectBodyHTMLValue) when() StepTime {
+	return ExpectStep
+}
+
+func (v *expectBodyHTMLValue) clearPath() clearPath {
+	return v.cleanPath
+}
+
+// value returns the value of the first element that matches the selector
+func (v *expectBodyHTMLValue) value(hit Hit) string {
+	nodes := hit.Response().body.HTML().find(v.selector)
+	if len(nodes) == 0 {
+		minitest.Errorf("%s does not match any element", v.selector)
+	}
+	return v.getter(nodes[0])
+}
+
+func (v *expectBodyHTMLValue) Equal(value interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: v.clearPath().Push("Equal", []interface{}{value}),
+		Exec: func(hit Hit) error {
+			compareData, err := makeCompareable(v.value(hit), value)
+			if err != nil {
+				return err
+			}
+			minitest.Equal(value, compareData)
+			return nil
+		},
+	}
+}
+
+func (v *expectBodyHTMLValue) NotEqual(value interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: v.clearPath().Push("NotEqual", []interface{}{value}),
+		Exec: func(hit Hit) error {
+			compareData, err := makeCompareable(v.value(hit), value)
+			if err != nil {
+				return err
+			}
+			minitest.NotEqual(value, compareData)
+			return nil
+		},
+	}
+}
+
+func (v *expectBodyHTMLValue) Contains(value interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: v.clearPath().Push("Contains", []interface{}{value}),
+		Exec: func(hit Hit) error {
+			minitest.Contains(v.value(hit), value)
+			return nil
+		},
+	}
+}
+
+func (v *expectBodyHTMLValue) NotContains(value interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: v.clearPath().Push("NotContains", []interface{}{value}),
+		Exec: func(hit Hit) error {
+			minitest.NotContains(v.value(hit), value)
+			return nil
+		},
+	}
+}
+
+func (v *expectBodyHTMLValue) OneOf(values ...interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: v.clearPath().Push("OneOf", values),
+		Exec: func(hit Hit) error {
+			minitest.Contains(values, v.value(hit))
+			return nil
+		},
+	}
+}
+
+func (v *expectBodyHTMLValue) NotOneOf(values ...interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: v.clearPath().Push("NotOneOf", values),
+		Exec: func(hit Hit) error {
+			minitest.NotContains(values, v.value(hit))
+			return nil
+		},
+	}
+}
+
+func (v *expectBodyHTMLValue) Empty() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: v.clearPath().Push("Empty", nil),
+		Exec: func(hit Hit) error {
+			minitest.Empty(v.value(hit))
+			return nil
+		},
+	}
+}
+
+func (v *expectBodyHTMLValue) NotEmpty() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: v.clearPath().Push("NotEmpty", nil),
+		Exec: func(hit Hit) error {
+			minitest.NotEmpty(v.value(hit))
+			return nil
+		},
+	}
+}
+
+func (v *expectBodyHTMLValue) Len(size int) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: v.clearPath().Push("Len", []interface{}{size}),
+		Exec: func(hit Hit) error {
+			minitest.Len(v.value(hit), size)
+			return nil
+		},
+	}
+}
+
+type finalExpectBodyHTML struct {
+	IStep
+	message string
+}
+
+func (h *finalExpectBodyHTML) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(h.message)
+		},
+	}
+}
+
+func (h *finalExpectBodyHTML) Select(string) IExpectBodyHTMLSelection {
+	return &finalExpectBodyHTMLSelection{
+		h.fail(),
+		h.message,
+	}
+}
+
+type finalExpectBodyHTMLSelection struct {
+	IStep
+	message string
+}
+
+func (sel *finalExpectBodyHTMLSelection) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(sel.message)
+		},
+	}
+}
+
+func (sel *finalExpectBodyHTMLSelection) Exists() IStep {
+	return sel.fail()
+}
+
+func (sel *finalExpectBodyHTMLSelection) NotExists() IStep {
+	return sel.fail()
+}
+
+func (sel *finalExpectBodyHTMLSelection) Count(int) IStep {
+	return sel.fail()
+}
+
+func (sel *finalExpectBodyHTMLSelection) Text() IExpectBodyHTMLValue {
+	return &finalExpectBodyHTMLValue{
+		sel.fail(),
+		sel.message,
+	}
+}
+
+func (sel *finalExpectBodyHTMLSelection) Attr(string) IExpectBodyHTMLValue {
+	return &finalExpectBodyHTMLValue{
+		sel.fail(),
+		sel.message,
+	}
+}
+
+type finalExpectBodyHTMLValue struct {
+	IStep
+	message string
+}
+
+func (v *finalExpectBodyHTMLValue) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(v.message)
+		},
+	}
+}
+
+func (v *finalExpectBodyHTMLValue) Equal(interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectBodyHTMLValue) NotEqual(interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectBodyHTMLValue) Contains(interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectBodyHTMLValue) NotContains(interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectBodyHTMLValue) OneOf(...interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectBodyHTMLValue) NotOneOf(...interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectBodyHTMLValue) Empty() IStep {
+	return v.fail()
+}
+
+func (v *finalExpectBodyHTMLValue) NotEmpty() IStep {
+	return v.fail()
+}
+
+func (v *finalExpectBodyHTMLValue) Len(int) IStep {
+	return v.fail()
+}
@@ -0,0 +1,206 @@
+package hit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+const htmlLogin = `<!DOCTYPE html>
+<html>
+<head><title>Login</title></head>
+<body>
+	<h1> Welcome back </h1>
+	<form id="login" action="/login" method="post">
+		<input type="hidden" name="csrf" value="abc123">
+		<input type="text" name="user">
+		<input type="password" name="password">
+	</form>
+	<ul id="menu"><li>Home</li><li class="active">About</li><li>Contact</li></ul>
+</body>
+</html>`
+
+func TestExpectBodyHTML_Select(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("Exists", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(htmlLogin),
+			Expect().Body().HTML().Select("form#login input[name=csrf]").Exists(),
+			Expect().Body().HTML().Select(".error").NotExists(),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(htmlLogin),
+				Expect().Body().HTML().Select("form#signup").Exists(),
+			),
+			PtrStr("form#signup does not match any element"),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(htmlLogin),
+				Expect().Body().HTML().Select("form input").NotExists(),
+			),
+			PtrStr("form input matches 3 element(s)"),
+		)
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(htmlLogin),
+			Expect().Body().HTML().Select("ul#menu > li").Count(3),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(htmlLogin),
+				Expect().Body().HTML().Select("ul#menu > li").Count(2),
+			),
+			PtrStr("ul#menu > li should match 2 element(s), but matches 3"),
+		)
+	})
+
+	t.Run("invalid selector", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(htmlLogin),
+				Expect().Body().HTML().Select("input[name").Exists(),
+			),
+			PtrStr(`unable to parse selector "input[name": expected an attribute operator but got '\x00'`),
+		)
+	})
+
+	t.Run("without chain", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(htmlLogin),
+				Expect().Body().HTML().Select("h1"),
+			),
+			PtrStr("unable to run Expect().Body().HTML().Select() without a chain. Please use Expect().Body().HTML().Select(selector).Something"),
+		)
+	})
+}
+
+func TestExpectBodyHTML_Text(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(htmlLogin),
+		Expect().Body().HTML().Select("h1").Text().Equal("Welcome back"),
+		Expect().Body().HTML().Select("h1").Text().NotEqual("Goodbye"),
+		Expect().Body().HTML().Select("h1").Text().Contains("Welcome"),
+		Expect().Body().HTML().Select("h1").Text().NotContains("Goodbye"),
+		Expect().Body().HTML().Select("li.active").Text().OneOf("About", "Contact"),
+		Expect().Body().HTML().Select("li.active").Text().NotOneOf("Home"),
+		Expect().Body().HTML().Select("form").Text().Empty(),
+		Expect().Body().HTML().Select("li").Text().NotEmpty(),
+		Expect().Body().HTML().Select("li").Text().Len(4),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(htmlLogin),
+			Expect().Body().HTML().Select("h1").Text().Contains("Goodbye"),
+		),
+		PtrStr(`"Welcome back" does not contain "Goodbye"`),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(htmlLogin),
+			Expect().Body().HTML().Select("h2").Text().Contains("Goodbye"),
+		),
+		PtrStr("h2 does not match any element"),
+	)
+}
+
+func TestExpectBodyHTML_Attr(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(htmlLogin),
+		Expect().Body().HTML().Select("form#login input[name=csrf]").Attr("value").NotEmpty(),
+		Expect().Body().HTML().Select("form#login input[name=csrf]").Attr("value").Equal("abc123"),
+		Expect().Body().HTML().Select("form#login").Attr("METHOD").Equal("post"),
+		Expect().Body().HTML().Select("form#login input[name=user]").Attr("value").Empty(),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(htmlLogin),
+			Expect().Body().HTML().Select("form#login input[name=user]").Attr("value").NotEmpty(),
+		),
+		PtrStr(`"" should not be empty`),
+	)
+}
+
+func TestExpectBodyHTML_Final(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Expect().Body("Hello").HTML().Select("h1").Text().Equal("Hello"),
+		),
+		PtrStr("only usable with Expect().Body() not with Expect().Body(value)"),
+	)
+}
+
+func TestHTTPHtml(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodGet {
+			_, _ = writer.Write([]byte(htmlLogin))
+			return
+		}
+		if request.FormValue("csrf") != "abc123" {
+			writer.WriteHeader(http.StatusForbidden)
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer s.Close()
+
+	var token string
+	Test(t,
+		Get(s.URL),
+		Expect().Custom(func(hit Hit) {
+			html := hit.Response().Body().HTML()
+			token = html.Attr("form#login input[name=csrf]", "value")
+			require.Equal(t, 3, html.Count("li"))
+			require.Equal(t, "Welcome back", html.Text("h1"))
+			require.Equal(t, []string{"Home", "About", "Contact"}, html.Texts("li"))
+			require.Equal(t, []string{"hidden", "text", "password"}, html.Attrs("input", "type"))
+			require.Empty(t, html.Attr("h2", "class"))
+		}),
+	)
+
+	Test(t,
+		Post(s.URL),
+		Send().Header("Content-Type", "application/x-www-form-urlencoded"),
+		Send().Custom(func(hit Hit) {
+			hit.Request().Body().SetString("csrf=" + token)
+		}),
+		Expect().Status(http.StatusNoContent),
+	)
+}
@@ -2,6 +2,7 @@ package hit_test
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/Eun/go-hit"
 	"github.com/stretchr/testify/require"
@@ -857,3 +858,409 @@ func TestExpectBodyJSON_WithoutArgument(t *testing.T) {
 		PtrStr("unable to run Expect().Body().JSON() without an argument or without a chain. Please use Expect().Body().JSON(something) or Expect().Body().JSON().Something"),
 	)
 }
+
+func TestExpectBodyJSON_Matches(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body().JSON(map[string]interface{}{"ID": 10, "Name": "Joe", "Email": "joe@example.com"}),
+		Expect().Body().JSON().Matches("Name", `^J\w+$`),
+		Expect().Body().JSON().Matches("ID", `^\d+$`),
+		Expect().Body().JSON().Matches("Email", `@(?P<domain>.+)$`),
+		Expect().Body().JSON().NotMatches("Name", `^A`),
+		Expect().Custom(func(hit Hit) {
+			require.Equal(t, "example.com", hit.Var("domain"))
+		}),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+			Expect().Body().JSON().Matches("Name", `^A`),
+		),
+		PtrStr(`"Joe" does not match ^A`),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+			Expect().Body().JSON().NotMatches("Name", `^J`),
+		),
+		PtrStr(`"Joe" should not match ^J`),
+	)
+}
+
+func TestExpectBodyJSON_Len(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(`{"Items": [1, 2, 3], "Name": "Joe"}`),
+		Expect().Body().JSON().Len("Items", 3),
+		Expect().Body().JSON().Len("Name", 3),
+		Expect().Body().JSON().Len("", 2),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Items": [1, 2, 3]}`),
+			Expect().Body().JSON().Len("Items", 2),
+		),
+		PtrStr("[]interface {}{"), nil, nil, nil, PtrStr("} should have 2 item(s), but has 3"),
+	)
+}
+
+func TestExpectBodyJSON_Exists(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(`{"Meta": {"Next": null}, "Items": [1]}`),
+		Expect().Body().JSON().Exists("Meta.Next"),
+		Expect().Body().JSON().Exists("Items.0"),
+		Expect().Body().JSON().NotExists("Meta.Prev"),
+		Expect().Body().JSON().NotExists("Items.1"),
+		Expect().Body().JSON().NotExists("Meta.Next.Page"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Meta": {}}`),
+			Expect().Body().JSON().Exists("Meta.Next"),
+		),
+		PtrStr("Meta.Next does not exist"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Password": "secret"}`),
+			Expect().Body().JSON().NotExists("Password"),
+		),
+		PtrStr(`Password does exist: "secret"`),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Name": "Joe"}`),
+			Expect().Body().JSON().NotExists("Name.First"),
+		),
+		PtrStr(`string cannot be used with the expression Name.First`),
+	)
+}
+
+func TestExpectBodyJSON_Type(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(`{"String": "a", "Number": 1.5, "Bool": true, "Object": {}, "Array": [], "Null": null}`),
+		Expect().Body().JSON().Type("String", "string"),
+		Expect().Body().JSON().Type("Number", "number"),
+		Expect().Body().JSON().Type("Bool", "boolean"),
+		Expect().Body().JSON().Type("Object", "object"),
+		Expect().Body().JSON().Type("Array", "array"),
+		Expect().Body().JSON().Type("Null", "null"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Price": "10"}`),
+			Expect().Body().JSON().Type("Price", "number"),
+		),
+		PtrStr(`"10" should be of type number, but is string`),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Price": 10}`),
+			Expect().Body().JSON().Type("Price", "int"),
+		),
+		PtrStr(`unknown type "int", use one of string, number, boolean, object, array or null`),
+	)
+}
+
+func TestExpectBodyJSON_Compare(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(`{"Count": 10, "Price": 2.5, "Name": "Joe", "Created": "2020-01-02T00:00:00Z"}`),
+		Expect().Body().JSON().GreaterThan("Count", 0),
+		Expect().Body().JSON().GreaterThan("Price", 2.4),
+		Expect().Body().JSON().GreaterThan("Name", "Alice"),
+		Expect().Body().JSON().GreaterThan("Created", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Expect().Body().JSON().LessThan("Count", uint(11)),
+		Expect().Body().JSON().GreaterOrEqualThan("Count", 10),
+		Expect().Body().JSON().LessOrEqualThan("Count", 10),
+		Expect().Body().JSON().Between("Price", 1, 3),
+		Expect().Body().JSON().Between("Count", 10, 10),
+		Expect().Body().JSON().OneOf("Name", "Alice", "Joe"),
+		Expect().Body().JSON().OneOf("Count", 5, 10),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Count": 0}`),
+			Expect().Body().JSON().GreaterThan("Count", 0),
+		),
+		PtrStr("0.000000 is not greater than 0"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Count": 11}`),
+			Expect().Body().JSON().Between("Count", 1, 10),
+		),
+		PtrStr("11.000000 is not between 1 and 10"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Name": "Bob"}`),
+			Expect().Body().JSON().OneOf("Name", "Alice", "Joe"),
+		),
+		PtrStr(`"Bob" is not one of []interface {}{`), nil, nil, PtrStr("}"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{}`),
+			Expect().Body().JSON().LessThan("Count", 10),
+		),
+		PtrStr("unable to compare nil with 10"),
+	)
+}
+
+func TestExpectBodyJSON_CompareFractions(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(`{"Price": 10.5, "Neg": -0.5, "Count": 10}`),
+		Expect().Body().JSON().GreaterThan("Price", 10),
+		Expect().Body().JSON().LessThan("Price", 11),
+		Expect().Body().JSON().LessThan("Neg", 0),
+		Expect().Body().JSON().GreaterThan("Neg", -1),
+		Expect().Body().JSON().GreaterOrEqualThan("Price", 10.5),
+		Expect().Body().JSON().Between("Price", 10, 11),
+		Expect().Body().JSON().Between("Neg", -1, 0),
+		Expect().Body().JSON().OneOf("Price", 10, 10.5),
+		Expect().Body().JSON().OneOf("Count", 5, 10),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Price": 10.5}`),
+			Expect().Body().JSON().Between("Price", 10, 10),
+		),
+		PtrStr("10.500000 is not between 10 and 10"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Price": 10.5}`),
+			Expect().Body().JSON().LessOrEqualThan("Price", 10),
+		),
+		PtrStr("10.500000 is not less or equal than 10"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Neg": -0.5}`),
+			Expect().Body().JSON().GreaterOrEqualThan("Neg", 0),
+		),
+		PtrStr("-0.500000 is not greater or equal than 0"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Price": 10.5}`),
+			Expect().Body().JSON().OneOf("Price", 10, 11),
+		),
+		PtrStr(`10.500000 is not one of []interface {}{`), nil, nil, PtrStr("}"),
+	)
+}
+
+func TestExpectBodyJSON_Each(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	body := `{"Items": [{"Name": "Apple", "Price": 2}, {"Name": "Pear", "Price": 3}], "Tags": {"a": "x", "b": "y"}}`
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(body),
+		Expect().Body().JSON().Each("Items", func(e IExpectJSONValue) {
+			e.Type("Name", "string")
+			e.GreaterThan("Price", 0)
+			e.Exists("Name")
+		}),
+		Expect().Body().JSON().Each("Tags", func(e IExpectJSONValue) {
+			e.Len("", 1)
+		}),
+		Expect().Body().JSON().Any("Items", func(e IExpectJSONValue) {
+			e.Equal("Name", "Pear")
+			e.Equal("Price", 3)
+		}),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(body),
+			Expect().Body().JSON().Each("Items", func(e IExpectJSONValue) {
+				e.LessThan("Price", 3)
+			}),
+		),
+		PtrStr("Items.1: 3.000000 is not less than 3"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(body),
+			Expect().Body().JSON().Any("Items", func(e IExpectJSONValue) {
+				e.Equal("Name", "Banana")
+			}),
+		),
+		PtrStr("no element passed the assertions:"),
+		PtrStr("Items.0: Not equal"), nil, nil, nil, nil, nil, nil,
+		PtrStr("Items.1: Not equal"), nil, nil, nil, nil, nil, nil,
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(body),
+			Expect().Body().JSON().Each("Items.0.Name", func(e IExpectJSONValue) {}),
+		),
+		PtrStr(`"Apple" is not an array or an object`),
+	)
+}
+
+func TestExpectBodyJSON_Subset(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	body := `{"ID": 10, "Name": "Joe", "Roles": ["Admin", "User"], "Items": [{"ID": 1, "Name": "Apple"}, {"ID": 2, "Name": "Pear"}]}`
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(body),
+		Expect().Body().JSON().Subset("", map[string]interface{}{"Name": "Joe"}),
+		Expect().Body().JSON().Subset("", map[string]interface{}{
+			"Items": []map[string]interface{}{{"Name": "Apple"}, {"Name": "Pear"}},
+		}),
+		Expect().Body().JSON().Subset("Items.1", struct{ Name string }{"Pear"}),
+		Expect().Body().JSON().Subset("", map[string]interface{}{
+			"Roles": []string{"User", "Admin"},
+			"Items": []map[string]interface{}{{"Name": "Pear"}, {"Name": "Apple"}},
+		}, IgnoreOrder("Roles", "Items")),
+		Expect().Body().JSON().Subset("", map[string]interface{}{"ID": 11, "Name": "Joe"}, IgnorePaths("ID")),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(body),
+			Expect().Body().JSON().Subset("", map[string]interface{}{"Name": "Alice"}),
+		),
+		PtrStr("Not equal"),
+		PtrStr("expected: map[string]interface {}{"), PtrStr(`"Name": "Alice",`), PtrStr("}"),
+		PtrStr("actual: map[string]interface {}{"), PtrStr(`"Name": "Joe",`), PtrStr("}"),
+		PtrStr("diff: map[string]interface{}{"), nil, nil, PtrStr("}"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(body),
+			Expect().Body().JSON().Subset("", map[string]interface{}{"Roles": []string{"User", "Admin"}}),
+		),
+		PtrStr("Not equal"),
+		PtrStr("expected: map[string]interface {}{"), nil, nil, nil, nil, nil,
+		PtrStr("actual: map[string]interface {}{"), nil, nil, nil, nil, nil,
+		PtrStr("diff: map[string]interface{}{"), nil, nil, nil, nil, nil, nil, nil,
+	)
+}
+
+func TestExpectBodyJSON_EqualOptions(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	body := `{"ID": 10, "Name": "Joe", "CreatedAt": "2020-01-01", "Tags": ["b", "a"], "Items": [{"ID": 1, "Name": "Apple"}, {"ID": 2, "Name": "Pear"}]}`
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(body),
+		Expect().Body().JSON().Equal("", map[string]interface{}{
+			"ID":   10,
+			"Name": "Joe",
+			"Tags": []string{"a", "b"},
+			"Items": []map[string]interface{}{
+				{"Name": "Apple"},
+				{"Name": "Pear"},
+			},
+		}, IgnorePaths("CreatedAt", "Items.*.ID"), IgnoreOrder("Tags")),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(body),
+			Expect().Body().JSON().Equal("", map[string]interface{}{"ID": 10, "Name": "Joe"}, IgnorePaths("CreatedAt", "Items")),
+		),
+		PtrStr("Not equal"),
+		PtrStr("expected: map[string]interface {}{"), nil, nil, PtrStr("}"),
+		PtrStr("actual: map[string]interface {}{"), nil, nil, nil, nil, nil, nil, PtrStr("}"),
+		PtrStr("diff: map[string]interface{}{"), nil, nil, nil, PtrStr("}"),
+	)
+
+	// paths are case sensitive like the keys of the compared values
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"ID": 10, "CreatedAt": "2020-01-01"}`),
+			Expect().Body().JSON().Equal("", map[string]interface{}{"ID": 10}, IgnorePaths("createdAt")),
+		),
+		PtrStr("Not equal"),
+		PtrStr("expected: map[string]interface {}{"), nil, PtrStr("}"),
+		PtrStr("actual: map[string]interface {}{"), nil, nil, PtrStr("}"),
+		PtrStr("diff: map[string]interface{}{"), nil, nil, PtrStr("}"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(`{"Tags": ["b", "a"]}`),
+			Expect().Body().JSON().Equal("", map[string]interface{}{"Tags": []string{"a", "b"}}, IgnoreOrder("tags")),
+		),
+		PtrStr("Not equal"),
+		PtrStr("expected: map[string]interface {}{"), nil, nil, nil, nil, PtrStr("}"),
+		PtrStr("actual: map[string]interface {}{"), nil, nil, nil, nil, PtrStr("}"),
+		PtrStr("diff: map[string]interface{}{"), nil, nil, nil, nil, nil, nil, PtrStr("}"),
+	)
+}
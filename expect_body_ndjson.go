@@ -0,0 +1,224 @@
+package hit
+
+import (
+	"encoding/json"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectBodyNDJSON provides assertions on a newline delimited json body (also known as json lines),
+// every non empty line of the body is a json value.
+type IExpectBodyNDJSON interface {
+	IStep
+	// Len expects the body to have the specified amount of json lines.
+	//
+	// Usage:
+	//     Expect().Body().NDJSON().Len(3)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/export"),
+	//         Expect().Body().NDJSON().Len(3),
+	//     )
+	Len(size int) IStep
+
+	// Each expects all lines to pass the assertions in fn, the lines are decoded one by one.
+	//
+	// Usage:
+	//     Expect().Body().NDJSON().Each(func(e IExpectJSONValue) {
+	//         e.Exists("ID")
+	//     })
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/export"),
+	//         Expect().Body().NDJSON().Each(func(e IExpectJSONValue) {
+	//             e.Type("ID", "number")
+	//             e.NotEqual("Name", "")
+	//         }),
+	//     )
+	Each(fn func(e IExpectJSONValue)) IStep
+
+	// Line provides assertions on the specified line, the first line is 0.
+	//
+	// Usage:
+	//     Expect().Body().NDJSON().Line(0).Equal("Name", "Joe")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/export"),
+	//         Expect().Body().NDJSON().Line(0).Equal("Name", "Joe"),
+	//         Expect().Body().NDJSON().Line(1).Equal("Name", "Alice"),
+	//     )
+	Line(n int) IExpectBodyNDJSONLine
+
+	// Stream enables the streaming mode, the lines are read directly from the connection and are not kept in
+	// memory. Use it for large bodies, the body can only be read once in this mode, so only one assertion on the
+	// body is possible.
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/export"),
+	//         Expect().Body().NDJSON().Stream(),
+	//         Expect().Body().NDJSON().Each(func(e IExpectJSONValue) {
+	//             e.Exists("ID")
+	//         }),
+	//     )
+	Stream() IStep
+}
+
+// IExpectBodyNDJSONLine provides assertions on one line of a newline delimited json body.
+//
+// See IExpectBodyJSON for usage and examples.
+type IExpectBodyNDJSONLine interface {
+	IStep
+	// Equal expects the json line to be equal to the specified value.
+	Equal(expression string, data interface{}, opts ...JSONOption) IStep
+	// NotEqual expects the json line to be not equal to the specified value.
+	NotEqual(expression string, data interface{}) IStep
+	// Contains expects the json line to contain the specified value.
+	Contains(expression string, data interface{}) IStep
+	// NotContains expects the json line to not contain the specified value.
+	NotContains(expression string, data interface{}) IStep
+	// Subset expects the json line to contain all object keys and values of the specified value.
+	Subset(expression string, data interface{}, opts ...JSONOption) IStep
+	// Len expects the json string, array or object to have the specified length.
+	Len(expression string, size int) IStep
+	// Exists expects the json value to exist.
+	Exists(expression string) IStep
+	// NotExists expects the json value to not exist.
+	NotExists(expression string) IStep
+}
+
+type expectBodyNDJSON struct {
+	expectBody IExpectBody
+	cleanPath  clearPath
+	trace      *errortrace.ErrorTrace
+}
+
+func newExpectBodyNDJSON(body IExpectBody, cleanPath clearPath) IExpectBodyNDJSON {
+	return &expectBodyNDJSON{
+		expectBody: body,
+		cleanPath:  cleanPath,
+		trace:      ett.Prepare(),
+	}
+}
+
+func (*expectBodyNDJSON) when() StepTime {
+	return ExpectStep
+}
+
+func (jsn *expectBodyNDJSON) exec(hit Hit) error {
+	return jsn.trace.Format(hit.Description(), "unable to run Expect().Body().NDJSON() without a chain. Please use Expect().Body().NDJSON().Something")
+}
+
+func (jsn *expectBodyNDJSON) clearPath() clearPath {
+	return jsn.cleanPath
+}
+
+func (jsn *expectBodyNDJSON) Len(size int) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: jsn.clearPath().Push("Len", []interface{}{size}),
+		Exec: func(hit Hit) error {
+			if l := hit.Response().body.NDJSON().Len(); l != size {
+				minitest.Errorf("body should have %d line(s), but has %d", size, l)
+			}
+			return nil
+		},
+	}
+}
+
+func (jsn *expectBodyNDJSON) Each(fn func(e IExpectJSONValue)) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: jsn.clearPath().Push("Each", []interface{}{fn}),
+		Exec: func(hit Hit) error {
+			return hit.Response().body.NDJSON().Lines(func(line int, data []byte) bool {
+				var container interface{}
+				if err := json.Unmarshal(data, &container); err != nil {
+					minitest.Errorf("line %d: %s", line, err.Error())
+				}
+				if err := runJSONValue(hit, container, fn); err != nil {
+					minitest.Errorf("line %d: %s", line, err.Error())
+				}
+				return true
+			})
+		},
+	}
+}
+
+func (jsn *expectBodyNDJSON) Line(n int) IExpectBodyNDJSONLine {
+	return newExpectJSONData(
+		jsn.clearPath().Push("Line", []interface{}{n}),
+		"Expect().Body().NDJSON().Line()",
+		func(hit Hit) ([]byte, error) {
+			var line []byte
+			err := hit.Response().body.NDJSON().Lines(func(i int, data []byte) bool {
+				if i != n {
+					return true
+				}
+				line = data
+				return false
+			})
+			if err != nil {
+				return nil, err
+			}
+			if line == nil {
+				return nil, xerrors.Errorf("line %d does not exist", n)
+			}
+			return line, nil
+		},
+	)
+}
+
+func (jsn *expectBodyNDJSON) Stream() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      BeforeExpectStep,
+		ClearPath: jsn.clearPath().Push("Stream", nil),
+		Exec: func(hit Hit) error {
+			hit.Response().body.NDJSON().SetStreaming(true)
+			return nil
+		},
+	}
+}
+
+type finalExpectBodyNDJSON struct {
+	IStep
+	message string
+}
+
+func (jsn *finalExpectBodyNDJSON) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(jsn.message)
+		},
+	}
+}
+
+func (jsn *finalExpectBodyNDJSON) Len(int) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectBodyNDJSON) Each(func(e IExpectJSONValue)) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectBodyNDJSON) Line(int) IExpectBodyNDJSONLine {
+	return &finalExpectJSONData{
+		jsn.fail(),
+		jsn.message,
+	}
+}
+
+func (jsn *finalExpectBodyNDJSON) Stream() IStep {
+	return jsn.fail()
+}
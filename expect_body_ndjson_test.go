@@ -0,0 +1,118 @@
+package hit_test
+
+import (
+	"testing"
+
+	. "github.com/Eun/go-hit"
+)
+
+const ndjsonUsers = `{"ID": 10, "Name": "Joe"}
+{"ID": 11, "Name": "Alice"}
+
+{"ID": 12, "Name": "Bob", "Roles": ["Admin"]}
+`
+
+func TestExpectBodyNDJSON(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("Len", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(ndjsonUsers),
+			Expect().Body().NDJSON().Len(3),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(ndjsonUsers),
+				Expect().Body().NDJSON().Len(2),
+			),
+			PtrStr("body should have 2 line(s), but has 3"),
+		)
+	})
+
+	t.Run("Each", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(ndjsonUsers),
+			Expect().Body().NDJSON().Each(func(e IExpectJSONValue) {
+				e.Type("ID", "number")
+				e.Exists("Name")
+			}),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(ndjsonUsers),
+				Expect().Body().NDJSON().Each(func(e IExpectJSONValue) {
+					e.Exists("Roles")
+				}),
+			),
+			PtrStr("line 0: Roles does not exist"),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body("{\"ID\": 10}\n{\"ID\": \n"),
+				Expect().Body().NDJSON().Each(func(e IExpectJSONValue) {}),
+			),
+			PtrStr("line 1: unexpected end of JSON input"),
+		)
+	})
+
+	t.Run("Line", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(ndjsonUsers),
+			Expect().Body().NDJSON().Line(0).Equal("Name", "Joe"),
+			Expect().Body().NDJSON().Line(1).Subset("", map[string]interface{}{"Name": "Alice"}),
+			Expect().Body().NDJSON().Line(2).Contains("Roles", "Admin"),
+			Expect().Body().NDJSON().Line(2).Len("Roles", 1),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(ndjsonUsers),
+				Expect().Body().NDJSON().Line(3).Exists("Name"),
+			),
+			PtrStr("line 3 does not exist"),
+		)
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(ndjsonUsers),
+			Expect().Body().NDJSON().Stream(),
+			Expect().Body().NDJSON().Each(func(e IExpectJSONValue) {
+				e.Exists("Name")
+			}),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(ndjsonUsers),
+				Expect().Body().NDJSON().Stream(),
+				Expect().Body().NDJSON().Len(3),
+				Expect().Body().NDJSON().Line(0).Equal("Name", "Joe"),
+			),
+			PtrStr("the body was already read, in streaming mode the body can only be read once"),
+		)
+	})
+
+	t.Run("final", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Expect().Body("Hello World").NDJSON().Len(1),
+			),
+			PtrStr("only usable with Expect().Body() not with Expect().Body(value)"),
+		)
+	})
+}
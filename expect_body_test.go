@@ -1,9 +1,11 @@
 package hit_test
 
 import (
+	"regexp"
 	"testing"
 
 	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExpectBody_Equal(t *testing.T) {
@@ -196,3 +198,66 @@ func TestExpectBody_WithoutArgument(t *testing.T) {
 		PtrStr("unable to run Expect().Body() without an argument or without a chain. Please use Expect().Body(something) or Expect().Body().Something"),
 	)
 }
+
+func TestExpectBody_Matches(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body("Your order 1234 was shipped"),
+		Expect().Body().Matches(`order \d+`),
+		Expect().Body().Matches(regexp.MustCompile(`(?i)SHIPPED`)),
+		Expect().Body().Matches(`order (?P<order>\d+)`),
+		Expect().Custom(func(hit Hit) {
+			require.Equal(t, "1234", hit.Var("order"))
+		}),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body("Hello World"),
+			Expect().Body().Matches(`^Hello \d+$`),
+		),
+		PtrStr(`"Hello World" does not match ^Hello \d+$`),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body("Hello World"),
+			Expect().Body().Matches(`(`),
+		),
+		PtrStr("unable to compile pattern \"(\": error parsing regexp: missing closing ): `(`"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body("Hello World"),
+			Expect().Body().Matches(10),
+		),
+		PtrStr("pattern must be a string or a *regexp.Regexp, got 10"),
+	)
+}
+
+func TestExpectBody_NotMatches(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body("Hello World"),
+		Expect().Body().NotMatches(`(?i)error`),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body("Hello World"),
+			Expect().Body().NotMatches(regexp.MustCompile(`World$`)),
+		),
+		PtrStr(`"Hello World" should not match World$`),
+	)
+}
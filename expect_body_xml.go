@@ -0,0 +1,341 @@
+package hit
+
+import (
+	"encoding/xml"
+	"reflect"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectBodyXML provides assertions on the http response xml body
+type IExpectBodyXML interface {
+	IStep
+	// Equal expects the xml body to be equal to the specified value.
+	//
+	// The first argument is an xpath expression that can be used to narrow down the compare path,
+	// if it is empty the body will be unmarshalled into the type of the specified value.
+	//
+	// given the following response: <user id="10"><name>Joe</name><role>Admin</role><role>User</role></user>
+	// Usage:
+	//     Expect().Body().XML().Equal("", User{ID: 10, Name: "Joe", Roles: []string{"Admin", "User"}})
+	//     Expect().Body().XML().Equal("/user/@id", 10)
+	//     Expect().Body().XML().Equal("/user/name", "Joe")
+	//     Expect().Body().XML().Equal("/user/role", []string{"Admin", "User"}),
+	//     Expect().Body().XML().Equal("/user/role[1]", "Admin"),
+	//
+	// Example:
+	//     // given the following response: <user id="10"><name>Joe</name><role>Admin</role><role>User</role></user>
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().XML().Equal("/user/name", "Joe"),
+	//         Expect().Body().XML().Equal("/user/role", []string{"Admin", "User"}),
+	//         Expect().Body().XML().Equal("/user/role[1]", "Admin"),
+	//     )
+	Equal(expression string, data interface{}) IStep
+
+	// NotEqual expects the xml body to be not equal to the specified value.
+	//
+	// The first argument is an xpath expression that can be used to narrow down the compare path
+	//
+	// see Equal() for usage and examples
+	NotEqual(expression string, data interface{}) IStep
+
+	// Contains expects the xml body to contain the specified value.
+	//
+	// The first argument is an xpath expression that can be used to narrow down the compare path
+	//
+	// given the following response: <user id="10"><name>Joe</name><role>Admin</role><role>User</role></user>
+	// Usage:
+	//     Expect().Body().XML().Contains("/user/name", "J")
+	//     Expect().Body().XML().Contains("/user/role", "Admin"),
+	//
+	// Example:
+	//     // given the following response: <user id="10"><name>Joe</name><role>Admin</role><role>User</role></user>
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().XML().Contains("/user/role", "Admin"),
+	//     )
+	Contains(expression string, data interface{}) IStep
+
+	// NotContains expects the xml body to not contain the specified value.
+	//
+	// The first argument is an xpath expression that can be used to narrow down the compare path
+	//
+	// see Contains() for usage and examples
+	NotContains(expression string, data interface{}) IStep
+
+	// Len expects the xpath expression to select the specified amount of nodes.
+	//
+	// Usage:
+	//     Expect().Body().XML().Len("/user/role", 2)
+	//
+	// Example:
+	//     // given the following response: <user id="10"><name>Joe</name><role>Admin</role><role>User</role></user>
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().XML().Len("/user/role", 2),
+	//     )
+	Len(expression string, size int) IStep
+
+	// Exists expects the xpath expression to select at least one node.
+	//
+	// Usage:
+	//     Expect().Body().XML().Exists("/user/@id")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().XML().Exists("/user/@id"),
+	//     )
+	Exists(expression string) IStep
+
+	// NotExists expects the xpath expression to select no node.
+	//
+	// Usage:
+	//     Expect().Body().XML().NotExists("/user/password")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Body().XML().NotExists("/user/password"),
+	//     )
+	NotExists(expression string) IStep
+}
+
+type expectBodyXML struct {
+	expectBody IExpectBody
+	cleanPath  clearPath
+	trace      *errortrace.ErrorTrace
+}
+
+func newExpectBodyXML(expectBody IExpectBody, cleanPath clearPath, params []interface{}) IExpectBodyXML {
+	x := &expectBodyXML{
+		expectBody: expectBody,
+		cleanPath:  cleanPath,
+		trace:      ett.Prepare(),
+	}
+
+	if param, ok := internal.GetLastArgument(params); ok {
+		return &finalExpectBodyXML{
+			&hitStep{
+				Trace:     x.trace,
+				When:      ExpectStep,
+				ClearPath: x.cleanPath,
+				Exec:      x.Equal("", param).exec,
+			},
+			"only usable with Expect().Body().XML() not with Expect().Body().XML(value)",
+		}
+	}
+	return x
+}
+
+func (x *expectBodyXML) exec(hit Hit) error {
+	return x.trace.Format(hit.Description(), "unable to run Expect().Body().XML() without an argument or without a chain. Please use Expect().Body().XML(something) or Expect().Body().XML().Something")
+}
+
+func (*expectBodyXML) when() StepTime {
+	return ExpectStep
+}
+
+func (x *expectBodyXML) clearPath() clearPath {
+	return x.cleanPath
+}
+
+// get returns the value selected by the expression,
+// if the expression is empty the body is unmarshalled into the type of data
+func (*expectBodyXML) get(hit Hit, expression string, data interface{}) (interface{}, error) {
+	if expression != "" {
+		return hit.Response().body.XML().Get(expression), nil
+	}
+	if data == nil {
+		return nil, xerrors.New("unable to compare the xml body with nil")
+	}
+	container := reflect.New(reflect.TypeOf(data))
+	if err := xml.NewDecoder(hit.Response().body.mustDecodedReader()).Decode(container.Interface()); err != nil {
+		return nil, err
+	}
+	return container.Elem().Interface(), nil
+}
+
+func (x *expectBodyXML) Equal(expression string, data interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: x.clearPath().Push("Equal", []interface{}{expression, data}),
+		Exec: func(hit Hit) error {
+			v, err := x.get(hit, expression, data)
+			if err != nil {
+				return err
+			}
+			if v == nil && data == nil {
+				return nil
+			}
+
+			if v == nil || data == nil {
+				// will fail
+				minitest.Equal(data, v)
+			}
+
+			compareData, err := makeCompareable(v, data)
+			if err != nil {
+				return err
+			}
+			minitest.Equal(data, compareData)
+			return nil
+		},
+	}
+}
+
+func (x *expectBodyXML) NotEqual(expression string, data interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: x.clearPath().Push("NotEqual", []interface{}{expression, data}),
+		Exec: func(hit Hit) error {
+			v, err := x.get(hit, expression, data)
+			if err != nil {
+				return err
+			}
+			if v == nil && data == nil {
+				minitest.Errorf("should not be %s", minitest.PrintValue(v))
+			}
+
+			if v == nil || data == nil {
+				minitest.NotEqual(data, v)
+				return nil
+			}
+
+			compareData, err := makeCompareable(v, data)
+			if err != nil {
+				return err
+			}
+			minitest.NotEqual(data, compareData)
+			return nil
+		},
+	}
+}
+
+func (x *expectBodyXML) Contains(expression string, data interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: x.clearPath().Push("Contains", []interface{}{expression, data}),
+		Exec: func(hit Hit) error {
+			v := hit.Response().body.XML().Get(expression)
+			if v == nil && data == nil {
+				return nil
+			}
+
+			if !internal.Contains(v, data) {
+				minitest.Errorf("%s does not contain %s", minitest.PrintValue(v), minitest.PrintValue(data))
+			}
+			return nil
+		},
+	}
+}
+
+func (x *expectBodyXML) NotContains(expression string, data interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: x.clearPath().Push("NotContains", []interface{}{expression, data}),
+		Exec: func(hit Hit) error {
+			v := hit.Response().body.XML().Get(expression)
+			if v == nil && data == nil {
+				minitest.Errorf("%s does contain %s", minitest.PrintValue(v), minitest.PrintValue(data))
+			}
+
+			if internal.Contains(v, data) {
+				minitest.Errorf("%s does contain %s", minitest.PrintValue(v), minitest.PrintValue(data))
+			}
+			return nil
+		},
+	}
+}
+
+func (x *expectBodyXML) Len(expression string, size int) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: x.clearPath().Push("Len", []interface{}{expression, size}),
+		Exec: func(hit Hit) error {
+			minitest.Len(hit.Response().body.XML().Nodes(expression), size)
+			return nil
+		},
+	}
+}
+
+func (x *expectBodyXML) Exists(expression string) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: x.clearPath().Push("Exists", []interface{}{expression}),
+		Exec: func(hit Hit) error {
+			if len(hit.Response().body.XML().Nodes(expression)) == 0 {
+				minitest.Errorf("%s does not exist", expression)
+			}
+			return nil
+		},
+	}
+}
+
+func (x *expectBodyXML) NotExists(expression string) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: x.clearPath().Push("NotExists", []interface{}{expression}),
+		Exec: func(hit Hit) error {
+			if nodes := hit.Response().body.XML().Nodes(expression); len(nodes) != 0 {
+				minitest.Errorf("%s does exist: %s", expression, minitest.PrintValue(nodes))
+			}
+			return nil
+		},
+	}
+}
+
+type finalExpectBodyXML struct {
+	IStep
+	message string
+}
+
+func (x *finalExpectBodyXML) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(x.message)
+		},
+	}
+}
+
+func (x *finalExpectBodyXML) Equal(string, interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalExpectBodyXML) NotEqual(string, interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalExpectBodyXML) Contains(string, interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalExpectBodyXML) NotContains(string, interface{}) IStep {
+	return x.fail()
+}
+
+func (x *finalExpectBodyXML) Len(string, int) IStep {
+	return x.fail()
+}
+
+func (x *finalExpectBodyXML) Exists(string) IStep {
+	return x.fail()
+}
+
+func (x *finalExpectBodyXML) NotExists(string) IStep {
+	return x.fail()
+}
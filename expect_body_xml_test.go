@@ -0,0 +1,232 @@
+package hit_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+const xmlUser = `<user id="10"><name>Joe</name><role>Admin</role><role>User</role></user>`
+
+type xmlUserType struct {
+	XMLName xml.Name `xml:"user"`
+	ID      int      `xml:"id,attr"`
+	Name    string   `xml:"name"`
+	Roles   []string `xml:"role"`
+}
+
+func TestExpectBodyXML_Equal(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("whole body", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML(xmlUserType{XMLName: xml.Name{Local: "user"}, ID: 10, Name: "Joe", Roles: []string{"Admin", "User"}}),
+		)
+
+		Test(t,
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML().NotEqual("", xmlUserType{XMLName: xml.Name{Local: "user"}, ID: 10, Name: "Alice"}),
+		)
+	})
+
+	t.Run("xpath", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML().Equal("/user/@id", 10),
+			Expect().Body().XML().Equal("/user/name", "Joe"),
+			Expect().Body().XML().Equal("/user/role", []string{"Admin", "User"}),
+			Expect().Body().XML().Equal("/user/role[2]", "User"),
+			Expect().Body().XML().Equal("count(/user/role)", 2),
+			Expect().Body().XML().Equal("/user/password", nil),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(xmlUser),
+				Expect().Body().XML().Equal("/user/name", "Alice"),
+			),
+			PtrStr("Not equal"), PtrStr(`expected: "Alice"`), PtrStr(`actual: "Joe"`), nil, nil, nil, nil,
+		)
+	})
+
+	t.Run("invalid xpath", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body(xmlUser),
+				Expect().Body().XML().Equal("/user[", "Joe"),
+			),
+			PtrStr(`unable to parse xpath "/user[": expected a node test but got ""`),
+		)
+	})
+}
+
+func TestExpectBodyXML_NotEqual(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(xmlUser),
+		Expect().Body().XML().NotEqual("/user/name", "Alice"),
+		Expect().Body().XML().NotEqual("/user/role", []string{"Admin"}),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML().NotEqual("/user/name", "Joe"),
+		),
+		PtrStr(`should not be "Joe"`),
+	)
+}
+
+func TestExpectBodyXML_Contains(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(xmlUser),
+		Expect().Body().XML().Contains("/user/name", "J"),
+		Expect().Body().XML().Contains("/user/role", "Admin"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML().Contains("/user/role", "Guest"),
+		),
+		PtrStr("[]string{"), PtrStr(`"Admin",`), PtrStr(`"User",`), PtrStr(`} does not contain "Guest"`),
+	)
+}
+
+func TestExpectBodyXML_NotContains(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(xmlUser),
+		Expect().Body().XML().NotContains("/user/role", "Guest"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML().NotContains("/user/role", "Admin"),
+		),
+		PtrStr("[]string{"), PtrStr(`"Admin",`), PtrStr(`"User",`), PtrStr(`} does contain "Admin"`),
+	)
+}
+
+func TestExpectBodyXML_Len(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(xmlUser),
+		Expect().Body().XML().Len("/user/role", 2),
+		Expect().Body().XML().Len("/user/password", 0),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML().Len("/user/role", 3),
+		),
+		PtrStr("[]string{"), PtrStr(`"Admin",`), PtrStr(`"User",`), PtrStr(`} should have 3 item(s), but has 2`),
+	)
+}
+
+func TestExpectBodyXML_Exists(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(xmlUser),
+		Expect().Body().XML().Exists("/user/@id"),
+		Expect().Body().XML().NotExists("/user/password"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML().Exists("/user/password"),
+		),
+		PtrStr("/user/password does not exist"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Send().Body(xmlUser),
+			Expect().Body().XML().NotExists("/user/name"),
+		),
+		PtrStr("/user/name does exist: []string{"), PtrStr(`"Joe",`), PtrStr("}"),
+	)
+}
+
+func TestExpectBodyXML_Final(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Expect().Body().XML("Joe").Equal("/user/name", "Joe"),
+		),
+		PtrStr("only usable with Expect().Body().XML() not with Expect().Body().XML(value)"),
+	)
+}
+
+func TestSendBody_XML(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body().XML(xmlUserType{ID: 10, Name: "Joe", Roles: []string{"Admin"}}),
+		Expect().Body(`<user id="10"><name>Joe</name><role>Admin</role></user>`),
+		Expect().Body().XML().Equal("/user/name", "Joe"),
+	)
+}
+
+func TestHTTPXml(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body(xmlUser),
+		Expect().Custom(func(hit Hit) {
+			require.Equal(t, "Joe", hit.Response().Body().XML().Get("/user/name"))
+			require.Equal(t, []string{"Admin", "User"}, hit.Response().Body().XML().Get("//role"))
+			require.Nil(t, hit.Response().Body().XML().Get("/user/password"))
+
+			var id int
+			hit.Response().Body().XML().GetAs("/user/@id", &id)
+			require.Equal(t, 10, id)
+
+			var user xmlUserType
+			hit.Response().Body().XML().GetAs("", &user)
+			require.Equal(t, "Joe", user.Name)
+			require.Equal(t, []string{"Admin", "User"}, user.Roles)
+		}),
+	)
+}
@@ -0,0 +1,352 @@
+package hit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectGraphQL provides assertions on a GraphQL response, the assertions are scoped to the data and errors
+// members of the response.
+type IExpectGraphQL interface {
+	IStep
+	// NoErrors expects the response to have no errors.
+	//
+	// Usage:
+	//     Expect().GraphQL().NoErrors()
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/graphql"),
+	//         Send().GraphQL("{ user(id: 1) { name } }", nil),
+	//         Expect().GraphQL().NoErrors(),
+	//     )
+	NoErrors() IStep
+
+	// Error provides assertions on the messages of the errors member, omit the chain to expect that there is at
+	// least one error.
+	//
+	// Usage:
+	//     Expect().GraphQL().Error()
+	//     Expect().GraphQL().Error().Contains("not found")
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/graphql"),
+	//         Send().GraphQL("{ user(id: 0) { name } }", nil),
+	//         Expect().GraphQL().Error().Contains("not found"),
+	//     )
+	Error() IExpectGraphQLError
+
+	// Data provides assertions on the data member, the expression is relative to the data member.
+	// Omit the expression to run assertions on the whole data member.
+	//
+	// Usage:
+	//     Expect().GraphQL().Data("user.name").Equal("Joe")
+	//     Expect().GraphQL().Data().Subset(map[string]interface{}{"user": map[string]interface{}{"name": "Joe"}})
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/graphql"),
+	//         Send().GraphQL("{ user(id: 1) { name } }", nil),
+	//         Expect().GraphQL().Data("user.name").Equal("Joe"),
+	//     )
+	Data(expression ...string) IExpectGraphQLData
+}
+
+// IExpectGraphQLError provides assertions on the error messages of a GraphQL response
+type IExpectGraphQLError interface {
+	IStep
+	// Equal expects one of the error messages to be equal to the specified message.
+	//
+	// Usage:
+	//     Expect().GraphQL().Error().Equal("user not found")
+	Equal(message string) IStep
+
+	// Contains expects one of the error messages to contain the specified value.
+	//
+	// Usage:
+	//     Expect().GraphQL().Error().Contains("not found")
+	Contains(value string) IStep
+
+	// NotContains expects none of the error messages to contain the specified value.
+	//
+	// Usage:
+	//     Expect().GraphQL().Error().NotContains("internal")
+	NotContains(value string) IStep
+
+	// Len expects the response to have the specified amount of errors.
+	//
+	// Usage:
+	//     Expect().GraphQL().Error().Len(1)
+	Len(size int) IStep
+}
+
+// IExpectGraphQLData provides assertions on the data member of a GraphQL response.
+//
+// See IExpectBodyJSON for usage and examples.
+type IExpectGraphQLData interface {
+	IStep
+	// Equal expects the value to be equal to the specified value.
+	Equal(data interface{}, opts ...JSONOption) IStep
+	// NotEqual expects the value to be not equal to the specified value.
+	NotEqual(data interface{}) IStep
+	// Contains expects the value to contain the specified value.
+	Contains(data interface{}) IStep
+	// NotContains expects the value to not contain the specified value.
+	NotContains(data interface{}) IStep
+	// Subset expects the value to contain all object keys and values of the specified value.
+	Subset(data interface{}, opts ...JSONOption) IStep
+	// Len expects the string, array or object to have the specified length.
+	Len(size int) IStep
+	// Exists expects the value to exist.
+	Exists() IStep
+	// NotExists expects the value to not exist.
+	NotExists() IStep
+	// Type expects the value to be of the specified json type (string, number, boolean, object, array or null).
+	Type(typ string) IStep
+}
+
+// graphQLResponse decodes the response body and returns the data and the error messages
+func graphQLResponse(hit Hit) (interface{}, []string) {
+	response := newExpectJSONBody(hit)
+	if _, ok := response.value.(map[string]interface{}); !ok {
+		minitest.Errorf("body is not a GraphQL response, expected a json object")
+	}
+	data, _, err := response.lookup("data")
+	minitest.NoError(err)
+	errs, ok, err := response.lookup("errors")
+	minitest.NoError(err)
+	if !ok || errs == nil {
+		return data, nil
+	}
+	list, ok := errs.([]interface{})
+	if !ok {
+		minitest.Errorf("errors member %s is not an array", minitest.PrintValue(errs))
+	}
+	messages := make([]string, len(list))
+	for i, e := range list {
+		if m, ok := e.(map[string]interface{}); ok {
+			if message, ok := m["message"].(string); ok {
+				messages[i] = message
+				continue
+			}
+		}
+		messages[i] = fmt.Sprint(e)
+	}
+	return data, messages
+}
+
+type expectGraphQL struct {
+	expect    IExpect
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newExpectGraphQL(expect IExpect, cleanPath clearPath) IExpectGraphQL {
+	return &expectGraphQL{
+		expect:    expect,
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (gql *expectGraphQL) exec(hit Hit) error {
+	return gql.trace.Format(hit.Description(), "unable to run Expect().GraphQL() without a chain. Please use Expect().GraphQL().Something")
+}
+
+func (*expectGraphQL) when() StepTime {
+	return ExpectStep
+}
+
+func (gql *expectGraphQL) clearPath() clearPath {
+	return gql.cleanPath
+}
+
+func (gql *expectGraphQL) NoErrors() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: gql.clearPath().Push("NoErrors", nil),
+		Exec: func(hit Hit) error {
+			if _, messages := graphQLResponse(hit); len(messages) > 0 {
+				minitest.Errorf("expected no errors, but got:\n%s", strings.Join(messages, "\n"))
+			}
+			return nil
+		},
+	}
+}
+
+func (gql *expectGraphQL) Error() IExpectGraphQLError {
+	return &expectGraphQLError{
+		cleanPath: gql.clearPath().Push("Error", nil),
+		trace:     ett.Prepare(),
+	}
+}
+
+func (gql *expectGraphQL) Data(expression ...string) IExpectGraphQLData {
+	args := make([]interface{}, len(expression))
+	for i := range expression {
+		args[i] = expression[i]
+	}
+	return newExpectJSONMember(
+		gql.clearPath().Push("Data", args),
+		"Expect().GraphQL().Data()",
+		joinExpression(expression),
+		func(hit Hit) interface{} {
+			data, _ := graphQLResponse(hit)
+			return data
+		},
+	)
+}
+
+type expectGraphQLError struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func (e *expectGraphQLError) exec(hit Hit) error {
+	// expect that there is at least one error
+	return (&hitStep{
+		Trace:     e.trace,
+		When:      ExpectStep,
+		ClearPath: e.cleanPath,
+		Exec: func(hit Hit) error {
+			if _, messages := graphQLResponse(hit); len(messages) == 0 {
+				minitest.Errorf("expected at least one error, but got none")
+			}
+			return nil
+		},
+	}).exec(hit)
+}
+
+func (*expectGraphQLError) when() StepTime {
+	return ExpectStep
+}
+
+func (e *expectGraphQLError) clearPath() clearPath {
+	return e.cleanPath
+}
+
+// step returns a step that runs fn with the error messages of the response
+func (e *expectGraphQLError) step(name string, args []interface{}, fn func(messages []string)) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: e.clearPath().Push(name, args),
+		Exec: func(hit Hit) error {
+			_, messages := graphQLResponse(hit)
+			fn(messages)
+			return nil
+		},
+	}
+}
+
+func (e *expectGraphQLError) Equal(message string) IStep {
+	return e.step("Equal", []interface{}{message}, func(messages []string) {
+		for _, m := range messages {
+			if m == message {
+				return
+			}
+		}
+		minitest.Errorf("no error message is equal to %s, got:\n%s", minitest.PrintValue(message), strings.Join(messages, "\n"))
+	})
+}
+
+func (e *expectGraphQLError) Contains(value string) IStep {
+	return e.step("Contains", []interface{}{value}, func(messages []string) {
+		for _, m := range messages {
+			if strings.Contains(m, value) {
+				return
+			}
+		}
+		minitest.Errorf("no error message contains %s, got:\n%s", minitest.PrintValue(value), strings.Join(messages, "\n"))
+	})
+}
+
+func (e *expectGraphQLError) NotContains(value string) IStep {
+	return e.step("NotContains", []interface{}{value}, func(messages []string) {
+		for _, m := range messages {
+			if strings.Contains(m, value) {
+				minitest.Errorf("error message %s contains %s", minitest.PrintValue(m), minitest.PrintValue(value))
+			}
+		}
+	})
+}
+
+func (e *expectGraphQLError) Len(size int) IStep {
+	return e.step("Len", []interface{}{size}, func(messages []string) {
+		if len(messages) != size {
+			minitest.Errorf("response should have %d error(s), but has %d", size, len(messages))
+		}
+	})
+}
+
+type finalExpectGraphQL struct {
+	IStep
+	message string
+}
+
+func (gql *finalExpectGraphQL) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(gql.message)
+		},
+	}
+}
+
+func (gql *finalExpectGraphQL) NoErrors() IStep {
+	return gql.fail()
+}
+
+func (gql *finalExpectGraphQL) Error() IExpectGraphQLError {
+	return &finalExpectGraphQLError{
+		gql.fail(),
+		gql.message,
+	}
+}
+
+func (gql *finalExpectGraphQL) Data(...string) IExpectGraphQLData {
+	return &finalExpectJSONMember{
+		gql.fail(),
+		gql.message,
+	}
+}
+
+type finalExpectGraphQLError struct {
+	IStep
+	message string
+}
+
+func (e *finalExpectGraphQLError) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(e.message)
+		},
+	}
+}
+
+func (e *finalExpectGraphQLError) Equal(string) IStep {
+	return e.fail()
+}
+
+func (e *finalExpectGraphQLError) Contains(string) IStep {
+	return e.fail()
+}
+
+func (e *finalExpectGraphQLError) NotContains(string) IStep {
+	return e.fail()
+}
+
+func (e *finalExpectGraphQLError) Len(int) IStep {
+	return e.fail()
+}
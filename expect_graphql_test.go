@@ -0,0 +1,145 @@
+package hit_test
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+)
+
+func TestSendGraphQL(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().GraphQL("query User($id: ID!) { user(id: $id) { name } }", map[string]interface{}{"id": 1}, "User"),
+		Expect().Header("Content-Type").Equal("application/json"),
+		Expect().Body().JSON().Equal("", map[string]interface{}{
+			"query":         "query User($id: ID!) { user(id: $id) { name } }",
+			"variables":     map[string]interface{}{"id": 1},
+			"operationName": "User",
+		}),
+	)
+
+	Test(t,
+		Post(s.URL),
+		Send().GraphQL("{ users { name } }", nil),
+		Expect().Body().JSON().Equal("", map[string]interface{}{
+			"query": "{ users { name } }",
+		}),
+	)
+}
+
+func TestExpectGraphQL(t *testing.T) {
+	s := PrintJSONServer(map[string]interface{}{
+		"data": map[string]interface{}{
+			"user": map[string]interface{}{
+				"name":  "Joe",
+				"roles": []string{"Admin", "User"},
+			},
+		},
+	})
+	defer s.Close()
+
+	errs := PrintJSONServer(map[string]interface{}{
+		"data": nil,
+		"errors": []interface{}{
+			map[string]interface{}{"message": "user not found", "path": []string{"user"}},
+			map[string]interface{}{"message": "permission denied"},
+		},
+	})
+	defer errs.Close()
+
+	t.Run("Data", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Expect().Status(http.StatusOK),
+			Expect().GraphQL().NoErrors(),
+			Expect().GraphQL().Data("user.name").Equal("Joe"),
+			Expect().GraphQL().Data("user", "name").NotEqual("Alice"),
+			Expect().GraphQL().Data("user.roles").Contains("Admin"),
+			Expect().GraphQL().Data("user.roles").Len(2),
+			Expect().GraphQL().Data("user.email").NotExists(),
+			Expect().GraphQL().Data().Subset(map[string]interface{}{"user": map[string]interface{}{"name": "Joe"}}),
+			Expect().GraphQL().Data("user").Type("object"),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Expect().GraphQL().Data("user.name").Equal("Alice"),
+			),
+			PtrStr("Not equal"), nil, nil, nil, nil, nil, nil,
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Expect().GraphQL().Data(),
+			),
+			PtrStr("unable to run Expect().GraphQL().Data() without a chain. Please use Expect().GraphQL().Data().Something"),
+		)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		Test(t,
+			Post(errs.URL),
+			Expect().GraphQL().Error(),
+			Expect().GraphQL().Error().Equal("user not found"),
+			Expect().GraphQL().Error().Contains("denied"),
+			Expect().GraphQL().Error().NotContains("internal"),
+			Expect().GraphQL().Error().Len(2),
+			Expect().GraphQL().Data().Equal(nil),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(errs.URL),
+				Expect().GraphQL().NoErrors(),
+			),
+			PtrStr("expected no errors, but got:"),
+			PtrStr("user not found"),
+			PtrStr("permission denied"),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(errs.URL),
+				Expect().GraphQL().Error().Contains("timeout"),
+			),
+			PtrStr(`no error message contains "timeout", got:`),
+			PtrStr("user not found"),
+			PtrStr("permission denied"),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Expect().GraphQL().Error(),
+			),
+			PtrStr("expected at least one error, but got none"),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(errs.URL),
+				Expect().GraphQL().Error().Len(1),
+			),
+			PtrStr("response should have 1 error(s), but has 2"),
+		)
+	})
+
+	t.Run("no GraphQL response", func(t *testing.T) {
+		invalid := PrintJSONServer([]int{1, 2})
+		defer invalid.Close()
+
+		ExpectError(t,
+			Do(
+				Post(invalid.URL),
+				Expect().GraphQL().NoErrors(),
+			),
+			PtrStr("body is not a GraphQL response, expected a json object"),
+		)
+	})
+}
@@ -1,6 +1,8 @@
 package hit
 
 import (
+	"net/http"
+
 	"github.com/Eun/go-hit/internal"
 	"github.com/Eun/go-hit/internal/minitest"
 	"golang.org/x/xerrors"
@@ -116,25 +118,77 @@ type IExpectHeader interface {
 	//         Expect().Header("Content-Type").NotEqual("application/json"),
 	//     )
 	NotEqual(value interface{}) IStep
+
+	// Matches expects the specified header to match the specified regular expression, the pattern can be a string
+	// or a *regexp.Regexp.
+	// If no header name was specified at least one header name must match the pattern.
+	//
+	// Named capture groups are stored and can be accessed in later steps with hit.Var(name).
+	//
+	// Usage:
+	//     Expect().Header().Matches(`^X-Request-`)
+	//     Expect().Header("Content-Type").Matches(`^application/(\w+\+)?json`)
+	//     Expect().Header("Location").Matches(`/users/(?P<id>\d+)$`)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Header("Location").Matches(`/users/(?P<id>\d+)$`),
+	//         Expect().Custom(func(hit Hit) {
+	//             fmt.Println(hit.Var("id"))
+	//         }),
+	//     )
+	Matches(pattern interface{}) IStep
+
+	// NotMatches expects the specified header to not match the specified regular expression, the pattern can be a
+	// string or a *regexp.Regexp.
+	// If no header name was specified no header name must match the pattern.
+	//
+	// Usage:
+	//     Expect().Header().NotMatches(`^X-Debug-`)
+	//     Expect().Header("Content-Type").NotMatches(`xml`)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Header("Content-Type").NotMatches(`xml`),
+	//     )
+	NotMatches(pattern interface{}) IStep
 }
 
-func newExpectHeader(expect IExpect, cleanPath clearPath, headerName ...string) IExpectHeader {
+// newExpectHeader returns the assertions for the headers that are returned by header, this way the assertions can be
+// used for the header and the trailer of the response
+func newExpectHeader(expect IExpect, cleanPath clearPath, header func(hit Hit) http.Header, headerName ...string) IExpectHeader {
 	name, ok := internal.GetLastStringArgument(headerName)
 	if ok {
-		return newExpectSpecificHeader(expect, cleanPath, name)
+		return newExpectSpecificHeader(expect, cleanPath, header, name)
 	}
-	return newExpectHeaders(expect, cleanPath)
+	return newExpectHeaders(expect, cleanPath, header)
+}
+
+// responseHeader returns the header of the response
+func responseHeader(hit Hit) http.Header {
+	return hit.Response().Header
+}
+
+// responseTrailer returns the trailer of the response, the trailer is only available after the body was read
+// so the body is read first
+func responseTrailer(hit Hit) http.Header {
+	hit.Response().Body().RawBytes()
+	return hit.Response().Trailer
 }
 
 type expectHeaders struct {
 	expect    IExpect
 	cleanPath clearPath
+	header    func(hit Hit) http.Header
 }
 
-func newExpectHeaders(expect IExpect, cleanPath clearPath) IExpectHeader {
+func newExpectHeaders(expect IExpect, cleanPath clearPath, header func(hit Hit) http.Header) IExpectHeader {
 	return &expectHeaders{
 		expect:    expect,
 		cleanPath: cleanPath,
+		header:    header,
 	}
 }
 
@@ -144,7 +198,7 @@ func (hdr *expectHeaders) Contains(headerName interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("Contains", []interface{}{headerName}),
 		Exec: func(hit Hit) error {
-			minitest.Contains(hit.Response().Header, headerName)
+			minitest.Contains(hdr.header(hit), headerName)
 			return nil
 		},
 	}
@@ -156,7 +210,7 @@ func (hdr *expectHeaders) NotContains(headerName interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("NotContains", []interface{}{headerName}),
 		Exec: func(hit Hit) error {
-			minitest.NotContains(hit.Response().Header, headerName)
+			minitest.NotContains(hdr.header(hit), headerName)
 			return nil
 		},
 	}
@@ -168,7 +222,7 @@ func (hdr *expectHeaders) Empty() IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("Empty", nil),
 		Exec: func(hit Hit) error {
-			minitest.Empty(hit.Response().Header)
+			minitest.Empty(hdr.header(hit))
 			return nil
 		},
 	}
@@ -180,7 +234,7 @@ func (hdr *expectHeaders) Len(size int) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("Len", []interface{}{size}),
 		Exec: func(hit Hit) error {
-			minitest.Len(hit.Response().Header, size)
+			minitest.Len(hdr.header(hit), size)
 			return nil
 		},
 	}
@@ -192,7 +246,7 @@ func (hdr *expectHeaders) Equal(value interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("Equal", []interface{}{value}),
 		Exec: func(hit Hit) error {
-			compareData, err := makeCompareable(hit.Response().Header, value)
+			compareData, err := makeCompareable(hdr.header(hit), value)
 			if err != nil {
 				return err
 			}
@@ -208,7 +262,7 @@ func (hdr *expectHeaders) NotEqual(value interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("NotEqual", []interface{}{value}),
 		Exec: func(hit Hit) error {
-			compareData, err := makeCompareable(hit.Response().Header, value)
+			compareData, err := makeCompareable(hdr.header(hit), value)
 			if err != nil {
 				return err
 			}
@@ -229,11 +283,11 @@ func (hdr *expectHeaders) OneOf(values ...interface{}) IStep {
 			if err := converter.Convert(values, &v); err != nil {
 				return err
 			}
-			var hdr map[string]string
-			if err := converter.Convert(hit.Response().Header, &hdr); err != nil {
+			var actual map[string]string
+			if err := converter.Convert(hdr.header(hit), &actual); err != nil {
 				return err
 			}
-			minitest.Contains(v, hdr)
+			minitest.Contains(v, actual)
 			return nil
 		},
 	}
@@ -250,11 +304,48 @@ func (hdr *expectHeaders) NotOneOf(values ...interface{}) IStep {
 			if err := converter.Convert(values, &v); err != nil {
 				return err
 			}
-			var hdr map[string]string
-			if err := converter.Convert(hit.Response().Header, &hdr); err != nil {
+			var actual map[string]string
+			if err := converter.Convert(hdr.header(hit), &actual); err != nil {
+				return err
+			}
+			minitest.NotContains(v, actual)
+			return nil
+		},
+	}
+}
+
+func (hdr *expectHeaders) Matches(pattern interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: hdr.cleanPath.Push("Matches", []interface{}{patternArgument(pattern)}),
+		Exec: func(hit Hit) error {
+			re, err := compileRegexp(pattern)
+			if err != nil {
 				return err
 			}
-			minitest.NotContains(v, hdr)
+			for name := range hdr.header(hit) {
+				if re.MatchString(name) {
+					return expectMatch(hit, re, name)
+				}
+			}
+			minitest.Errorf("no header matches %s", re.String())
+			return nil
+		},
+	}
+}
+
+func (hdr *expectHeaders) NotMatches(pattern interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: hdr.cleanPath.Push("NotMatches", []interface{}{patternArgument(pattern)}),
+		Exec: func(hit Hit) error {
+			for name := range hdr.header(hit) {
+				if err := expectNoMatch(pattern, name); err != nil {
+					return err
+				}
+			}
 			return nil
 		},
 	}
@@ -263,14 +354,16 @@ func (hdr *expectHeaders) NotOneOf(values ...interface{}) IStep {
 type expectSpecificHeader struct {
 	expect    IExpect
 	cleanPath clearPath
-	header    string
+	header    func(hit Hit) http.Header
+	name      string
 }
 
-func newExpectSpecificHeader(expect IExpect, cleanPath clearPath, header string) IExpectHeader {
+func newExpectSpecificHeader(expect IExpect, cleanPath clearPath, header func(hit Hit) http.Header, name string) IExpectHeader {
 	return &expectSpecificHeader{
 		expect:    expect,
 		cleanPath: cleanPath,
 		header:    header,
+		name:      name,
 	}
 }
 
@@ -280,7 +373,7 @@ func (hdr *expectSpecificHeader) Contains(value interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("Contains", []interface{}{value}),
 		Exec: func(hit Hit) error {
-			minitest.Contains(hit.Response().Header.Get(hdr.header), value)
+			minitest.Contains(hdr.header(hit).Get(hdr.name), value)
 			return nil
 		},
 	}
@@ -292,7 +385,7 @@ func (hdr *expectSpecificHeader) NotContains(value interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("NotContains", []interface{}{value}),
 		Exec: func(hit Hit) error {
-			minitest.NotContains(hit.Response().Header.Get(hdr.header), value)
+			minitest.NotContains(hdr.header(hit).Get(hdr.name), value)
 			return nil
 		},
 	}
@@ -304,7 +397,7 @@ func (hdr *expectSpecificHeader) OneOf(values ...interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("OneOf", values),
 		Exec: func(hit Hit) error {
-			minitest.Contains(values, hit.Response().Header.Get(hdr.header))
+			minitest.Contains(values, hdr.header(hit).Get(hdr.name))
 			return nil
 		},
 	}
@@ -316,7 +409,7 @@ func (hdr *expectSpecificHeader) NotOneOf(values ...interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("NotOneOf", values),
 		Exec: func(hit Hit) error {
-			minitest.NotContains(values, hit.Response().Header.Get(hdr.header))
+			minitest.NotContains(values, hdr.header(hit).Get(hdr.name))
 			return nil
 		},
 	}
@@ -328,7 +421,7 @@ func (hdr *expectSpecificHeader) Empty() IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("Empty", nil),
 		Exec: func(hit Hit) error {
-			minitest.Empty(hit.Response().Header.Get(hdr.header))
+			minitest.Empty(hdr.header(hit).Get(hdr.name))
 			return nil
 		},
 	}
@@ -340,7 +433,7 @@ func (hdr *expectSpecificHeader) Len(size int) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("Len", []interface{}{size}),
 		Exec: func(hit Hit) error {
-			minitest.Len(hit.Response().Header.Get(hdr.header), size)
+			minitest.Len(hdr.header(hit).Get(hdr.name), size)
 			return nil
 		},
 	}
@@ -352,7 +445,7 @@ func (hdr *expectSpecificHeader) Equal(value interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("Equal", []interface{}{value}),
 		Exec: func(hit Hit) error {
-			compareData, err := makeCompareable(hit.Response().Header.Get(hdr.header), value)
+			compareData, err := makeCompareable(hdr.header(hit).Get(hdr.name), value)
 			if err != nil {
 				return err
 			}
@@ -368,7 +461,7 @@ func (hdr *expectSpecificHeader) NotEqual(value interface{}) IStep {
 		When:      ExpectStep,
 		ClearPath: hdr.cleanPath.Push("NotEqual", []interface{}{value}),
 		Exec: func(hit Hit) error {
-			compareData, err := makeCompareable(hit.Response().Header.Get(hdr.header), value)
+			compareData, err := makeCompareable(hdr.header(hit).Get(hdr.name), value)
 			if err != nil {
 				return err
 			}
@@ -378,6 +471,28 @@ func (hdr *expectSpecificHeader) NotEqual(value interface{}) IStep {
 	}
 }
 
+func (hdr *expectSpecificHeader) Matches(pattern interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: hdr.cleanPath.Push("Matches", []interface{}{patternArgument(pattern)}),
+		Exec: func(hit Hit) error {
+			return expectMatch(hit, pattern, hdr.header(hit).Get(hdr.name))
+		},
+	}
+}
+
+func (hdr *expectSpecificHeader) NotMatches(pattern interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: hdr.cleanPath.Push("NotMatches", []interface{}{patternArgument(pattern)}),
+		Exec: func(hit Hit) error {
+			return expectNoMatch(pattern, hdr.header(hit).Get(hdr.name))
+		},
+	}
+}
+
 type finalExpectHeader struct {
 	IStep
 	message string
@@ -425,3 +540,11 @@ func (hdr *finalExpectHeader) Equal(interface{}) IStep {
 func (hdr *finalExpectHeader) NotEqual(interface{}) IStep {
 	return hdr.fail()
 }
+
+func (hdr *finalExpectHeader) Matches(interface{}) IStep {
+	return hdr.fail()
+}
+
+func (hdr *finalExpectHeader) NotMatches(interface{}) IStep {
+	return hdr.fail()
+}
@@ -168,3 +168,34 @@ func TestExpectHeaders_Empty(t *testing.T) {
 		Expect().Header().Len(0),
 	)
 }
+
+func TestExpectHeaders_Matches(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("X-Request-Id", "10")
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Expect().Header().Matches(`^X-Request-`),
+		Expect().Header().NotMatches(`^X-Debug-`),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Expect().Header().Matches(`^X-Debug-`),
+		),
+		PtrStr("no header matches ^X-Debug-"),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Expect().Header().NotMatches(`^X-Request-`),
+		),
+		PtrStr(`"X-Request-Id" should not match ^X-Request-`),
+	)
+}
@@ -0,0 +1,276 @@
+package hit
+
+import (
+	"encoding/json"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// expectJSONData provides json assertions on data that is read from a stream,
+// e.g. the data of the next server-sent event or the next websocket message
+type expectJSONData struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+	name      string
+	decode    func(hit Hit) (interface{}, error)
+}
+
+func newExpectJSONData(cleanPath clearPath, name string, next func(hit Hit) ([]byte, error)) *expectJSONData {
+	return newExpectDecodedData(cleanPath, name, func(hit Hit) (interface{}, error) {
+		data, err := next(hit)
+		if err != nil {
+			return nil, err
+		}
+		var container interface{}
+		minitest.NoError(json.Unmarshal(data, &container))
+		return container, nil
+	})
+}
+
+// newExpectDecodedData provides the json assertions on a value that was decoded by the specified function,
+// e.g. a body that was decoded with a codec
+func newExpectDecodedData(cleanPath clearPath, name string, decode func(hit Hit) (interface{}, error)) *expectJSONData {
+	return &expectJSONData{
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+		name:      name,
+		decode:    decode,
+	}
+}
+
+func (jsn *expectJSONData) exec(hit Hit) error {
+	return jsn.trace.Format(hit.Description(), "unable to run "+jsn.name+" without a chain. Please use "+jsn.name+".Something")
+}
+
+func (*expectJSONData) when() StepTime {
+	return ExpectStep
+}
+
+func (jsn *expectJSONData) clearPath() clearPath {
+	return jsn.cleanPath
+}
+
+// step returns a step that decodes the data and runs fn with it
+func (jsn *expectJSONData) step(name string, args []interface{}, fn func(v *expectJSONValue)) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: jsn.clearPath().Push(name, args),
+		Exec: func(hit Hit) error {
+			container, err := jsn.decode(hit)
+			if err != nil {
+				return err
+			}
+			fn(newExpectJSONValue(hit, container))
+			return nil
+		},
+	}
+}
+
+func (jsn *expectJSONData) Equal(expression string, data interface{}, opts ...JSONOption) IStep {
+	return jsn.step("Equal", []interface{}{expression, data}, func(v *expectJSONValue) {
+		v.Equal(expression, data, opts...)
+	})
+}
+
+func (jsn *expectJSONData) NotEqual(expression string, data interface{}) IStep {
+	return jsn.step("NotEqual", []interface{}{expression, data}, func(v *expectJSONValue) {
+		v.NotEqual(expression, data)
+	})
+}
+
+func (jsn *expectJSONData) Contains(expression string, data interface{}) IStep {
+	return jsn.step("Contains", []interface{}{expression, data}, func(v *expectJSONValue) {
+		v.Contains(expression, data)
+	})
+}
+
+func (jsn *expectJSONData) NotContains(expression string, data interface{}) IStep {
+	return jsn.step("NotContains", []interface{}{expression, data}, func(v *expectJSONValue) {
+		v.NotContains(expression, data)
+	})
+}
+
+func (jsn *expectJSONData) Subset(expression string, data interface{}, opts ...JSONOption) IStep {
+	return jsn.step("Subset", []interface{}{expression, data}, func(v *expectJSONValue) {
+		v.Subset(expression, data, opts...)
+	})
+}
+
+func (jsn *expectJSONData) Len(expression string, size int) IStep {
+	return jsn.step("Len", []interface{}{expression, size}, func(v *expectJSONValue) {
+		v.Len(expression, size)
+	})
+}
+
+func (jsn *expectJSONData) Exists(expression string) IStep {
+	return jsn.step("Exists", []interface{}{expression}, func(v *expectJSONValue) {
+		v.Exists(expression)
+	})
+}
+
+func (jsn *expectJSONData) NotExists(expression string) IStep {
+	return jsn.step("NotExists", []interface{}{expression}, func(v *expectJSONValue) {
+		v.NotExists(expression)
+	})
+}
+
+func (jsn *expectJSONData) Matches(expression string, pattern interface{}) IStep {
+	return jsn.step("Matches", []interface{}{expression, patternArgument(pattern)}, func(v *expectJSONValue) {
+		v.Matches(expression, pattern)
+	})
+}
+
+func (jsn *expectJSONData) NotMatches(expression string, pattern interface{}) IStep {
+	return jsn.step("NotMatches", []interface{}{expression, patternArgument(pattern)}, func(v *expectJSONValue) {
+		v.NotMatches(expression, pattern)
+	})
+}
+
+func (jsn *expectJSONData) Type(expression string, typ string) IStep {
+	return jsn.step("Type", []interface{}{expression, typ}, func(v *expectJSONValue) {
+		v.Type(expression, typ)
+	})
+}
+
+func (jsn *expectJSONData) GreaterThan(expression string, data interface{}) IStep {
+	return jsn.step("GreaterThan", []interface{}{expression, data}, func(v *expectJSONValue) {
+		v.GreaterThan(expression, data)
+	})
+}
+
+func (jsn *expectJSONData) LessThan(expression string, data interface{}) IStep {
+	return jsn.step("LessThan", []interface{}{expression, data}, func(v *expectJSONValue) {
+		v.LessThan(expression, data)
+	})
+}
+
+func (jsn *expectJSONData) GreaterOrEqualThan(expression string, data interface{}) IStep {
+	return jsn.step("GreaterOrEqualThan", []interface{}{expression, data}, func(v *expectJSONValue) {
+		v.GreaterOrEqualThan(expression, data)
+	})
+}
+
+func (jsn *expectJSONData) LessOrEqualThan(expression string, data interface{}) IStep {
+	return jsn.step("LessOrEqualThan", []interface{}{expression, data}, func(v *expectJSONValue) {
+		v.LessOrEqualThan(expression, data)
+	})
+}
+
+func (jsn *expectJSONData) Between(expression string, min, max interface{}) IStep {
+	return jsn.step("Between", []interface{}{expression, min, max}, func(v *expectJSONValue) {
+		v.Between(expression, min, max)
+	})
+}
+
+func (jsn *expectJSONData) OneOf(expression string, values ...interface{}) IStep {
+	return jsn.step("OneOf", append([]interface{}{expression}, values...), func(v *expectJSONValue) {
+		v.OneOf(expression, values...)
+	})
+}
+
+func (jsn *expectJSONData) Each(expression string, fn func(e IExpectJSONValue)) IStep {
+	return jsn.step("Each", []interface{}{expression, fn}, func(v *expectJSONValue) {
+		v.Each(expression, fn)
+	})
+}
+
+func (jsn *expectJSONData) Any(expression string, fn func(e IExpectJSONValue)) IStep {
+	return jsn.step("Any", []interface{}{expression, fn}, func(v *expectJSONValue) {
+		v.Any(expression, fn)
+	})
+}
+
+type finalExpectJSONData struct {
+	IStep
+	message string
+}
+
+func (jsn *finalExpectJSONData) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(jsn.message)
+		},
+	}
+}
+
+func (jsn *finalExpectJSONData) Equal(string, interface{}, ...JSONOption) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) NotEqual(string, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) Contains(string, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) NotContains(string, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) Subset(string, interface{}, ...JSONOption) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) Len(string, int) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) Exists(string) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) NotExists(string) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) Matches(string, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) NotMatches(string, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) Type(string, string) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) GreaterThan(string, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) LessThan(string, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) GreaterOrEqualThan(string, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) LessOrEqualThan(string, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) Between(string, interface{}, interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) OneOf(string, ...interface{}) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) Each(string, func(e IExpectJSONValue)) IStep {
+	return jsn.fail()
+}
+
+func (jsn *finalExpectJSONData) Any(string, func(e IExpectJSONValue)) IStep {
+	return jsn.fail()
+}
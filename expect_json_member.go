@@ -0,0 +1,164 @@
+package hit
+
+import (
+	"strings"
+
+	"github.com/Eun/go-hit/errortrace"
+	"golang.org/x/xerrors"
+)
+
+// expectJSONMember provides json assertions on a member of a json response,
+// e.g. the data member of a GraphQL response
+type expectJSONMember struct {
+	cleanPath  clearPath
+	trace      *errortrace.ErrorTrace
+	name       string
+	expression string
+	get        func(hit Hit) interface{}
+}
+
+func newExpectJSONMember(cleanPath clearPath, name, expression string, get func(hit Hit) interface{}) *expectJSONMember {
+	return &expectJSONMember{
+		cleanPath:  cleanPath,
+		trace:      ett.Prepare(),
+		name:       name,
+		expression: expression,
+		get:        get,
+	}
+}
+
+// joinExpression joins the expression parts that were passed to functions like Expect().GraphQL().Data()
+func joinExpression(expression []string) string {
+	return strings.Join(expression, ".")
+}
+
+func (m *expectJSONMember) exec(hit Hit) error {
+	return m.trace.Format(hit.Description(), "unable to run "+m.name+" without a chain. Please use "+m.name+".Something")
+}
+
+func (*expectJSONMember) when() StepTime {
+	return ExpectStep
+}
+
+func (m *expectJSONMember) clearPath() clearPath {
+	return m.cleanPath
+}
+
+// step returns a step that runs fn with the member
+func (m *expectJSONMember) step(name string, args []interface{}, fn func(v *expectJSONValue)) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: m.clearPath().Push(name, args),
+		Exec: func(hit Hit) error {
+			fn(newExpectJSONValue(hit, m.get(hit)))
+			return nil
+		},
+	}
+}
+
+func (m *expectJSONMember) Equal(data interface{}, opts ...JSONOption) IStep {
+	return m.step("Equal", []interface{}{data}, func(v *expectJSONValue) {
+		v.Equal(m.expression, data, opts...)
+	})
+}
+
+func (m *expectJSONMember) NotEqual(data interface{}) IStep {
+	return m.step("NotEqual", []interface{}{data}, func(v *expectJSONValue) {
+		v.NotEqual(m.expression, data)
+	})
+}
+
+func (m *expectJSONMember) Contains(data interface{}) IStep {
+	return m.step("Contains", []interface{}{data}, func(v *expectJSONValue) {
+		v.Contains(m.expression, data)
+	})
+}
+
+func (m *expectJSONMember) NotContains(data interface{}) IStep {
+	return m.step("NotContains", []interface{}{data}, func(v *expectJSONValue) {
+		v.NotContains(m.expression, data)
+	})
+}
+
+func (m *expectJSONMember) Subset(data interface{}, opts ...JSONOption) IStep {
+	return m.step("Subset", []interface{}{data}, func(v *expectJSONValue) {
+		v.Subset(m.expression, data, opts...)
+	})
+}
+
+func (m *expectJSONMember) Len(size int) IStep {
+	return m.step("Len", []interface{}{size}, func(v *expectJSONValue) {
+		v.Len(m.expression, size)
+	})
+}
+
+func (m *expectJSONMember) Exists() IStep {
+	return m.step("Exists", nil, func(v *expectJSONValue) {
+		v.Exists(m.expression)
+	})
+}
+
+func (m *expectJSONMember) NotExists() IStep {
+	return m.step("NotExists", nil, func(v *expectJSONValue) {
+		v.NotExists(m.expression)
+	})
+}
+
+func (m *expectJSONMember) Type(typ string) IStep {
+	return m.step("Type", []interface{}{typ}, func(v *expectJSONValue) {
+		v.Type(m.expression, typ)
+	})
+}
+
+type finalExpectJSONMember struct {
+	IStep
+	message string
+}
+
+func (m *finalExpectJSONMember) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(m.message)
+		},
+	}
+}
+
+func (m *finalExpectJSONMember) Equal(interface{}, ...JSONOption) IStep {
+	return m.fail()
+}
+
+func (m *finalExpectJSONMember) NotEqual(interface{}) IStep {
+	return m.fail()
+}
+
+func (m *finalExpectJSONMember) Contains(interface{}) IStep {
+	return m.fail()
+}
+
+func (m *finalExpectJSONMember) NotContains(interface{}) IStep {
+	return m.fail()
+}
+
+func (m *finalExpectJSONMember) Subset(interface{}, ...JSONOption) IStep {
+	return m.fail()
+}
+
+func (m *finalExpectJSONMember) Len(int) IStep {
+	return m.fail()
+}
+
+func (m *finalExpectJSONMember) Exists() IStep {
+	return m.fail()
+}
+
+func (m *finalExpectJSONMember) NotExists() IStep {
+	return m.fail()
+}
+
+func (m *finalExpectJSONMember) Type(string) IStep {
+	return m.fail()
+}
@@ -0,0 +1,517 @@
+package hit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Eun/go-hit/expr"
+	"github.com/Eun/go-hit/internal"
+	"github.com/Eun/go-hit/internal/minitest"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/xerrors"
+)
+
+// IExpectJSONValue provides assertions on a json value.
+//
+// It is passed to the callbacks of Expect().Body().JSON().Each() and Expect().Body().JSON().Any(), the assertions
+// run immediately and the expressions are relative to the value. Use an empty expression to assert the value itself.
+type IExpectJSONValue interface {
+	// Equal expects the value to be equal to the specified value.
+	Equal(expression string, data interface{}, opts ...JSONOption)
+	// NotEqual expects the value to be not equal to the specified value.
+	NotEqual(expression string, data interface{})
+	// Contains expects the value to contain the specified value.
+	Contains(expression string, data interface{})
+	// NotContains expects the value to not contain the specified value.
+	NotContains(expression string, data interface{})
+	// Subset expects the value to contain all object keys and values of the specified value.
+	Subset(expression string, data interface{}, opts ...JSONOption)
+	// Matches expects the value to match the specified regular expression.
+	Matches(expression string, pattern interface{})
+	// NotMatches expects the value to not match the specified regular expression.
+	NotMatches(expression string, pattern interface{})
+	// Len expects the string, array or object to have the specified length.
+	Len(expression string, size int)
+	// Exists expects the value to exist.
+	Exists(expression string)
+	// NotExists expects the value to not exist.
+	NotExists(expression string)
+	// Type expects the value to be of the specified json type (string, number, boolean, object, array or null).
+	Type(expression string, typ string)
+	// GreaterThan expects the value to be greater than the specified value.
+	GreaterThan(expression string, data interface{})
+	// LessThan expects the value to be less than the specified value.
+	LessThan(expression string, data interface{})
+	// GreaterOrEqualThan expects the value to be greater or equal than the specified value.
+	GreaterOrEqualThan(expression string, data interface{})
+	// LessOrEqualThan expects the value to be less or equal than the specified value.
+	LessOrEqualThan(expression string, data interface{})
+	// Between expects the value to be between min and max (inclusive).
+	Between(expression string, min, max interface{})
+	// OneOf expects the value to be equal to one of the specified values.
+	OneOf(expression string, values ...interface{})
+	// Each expects all elements of the array or object to pass the assertions in fn.
+	Each(expression string, fn func(e IExpectJSONValue))
+	// Any expects at least one element of the array or object to pass the assertions in fn.
+	Any(expression string, fn func(e IExpectJSONValue))
+}
+
+type expectJSONValue struct {
+	hit   Hit
+	value interface{}
+}
+
+func newExpectJSONValue(hit Hit, value interface{}) *expectJSONValue {
+	return &expectJSONValue{
+		hit:   hit,
+		value: value,
+	}
+}
+
+// newExpectJSONBody returns an expectJSONValue for the decoded response body
+func newExpectJSONBody(hit Hit) *expectJSONValue {
+	return newExpectJSONValue(hit, hit.Response().body.JSON().Get(""))
+}
+
+// get returns the value for the expression, values that were not found are nil
+func (jsn *expectJSONValue) get(expression string) interface{} {
+	v, ok, err := expr.GetValue(jsn.value, expression, expr.IgnoreCase)
+	minitest.NoError(err)
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+// lookup returns the value for the expression and whether it exists, members of null values do not exist
+func (jsn *expectJSONValue) lookup(expression string) (interface{}, bool, error) {
+	v, ok, err := expr.GetValue(jsn.value, expression, expr.IgnoreCase)
+	if err == nil {
+		return v, ok, nil
+	}
+	parts := strings.Split(expression, ".")
+	for i := len(parts) - 1; i > 0; i-- {
+		parent, found, parentErr := expr.GetValue(jsn.value, strings.Join(parts[:i], "."), expr.IgnoreCase)
+		if parentErr != nil || !found {
+			continue
+		}
+		if parent == nil {
+			return nil, false, nil
+		}
+		break
+	}
+	return nil, false, err
+}
+
+func (jsn *expectJSONValue) Equal(expression string, data interface{}, opts ...JSONOption) {
+	v := jsn.get(expression)
+	if len(opts) > 0 {
+		compareJSON(data, v, false, opts)
+		return
+	}
+	if v == nil && data == nil {
+		return
+	}
+
+	if v == nil || data == nil {
+		// will fail
+		minitest.Equal(data, v)
+	}
+
+	compareData, err := makeCompareable(v, data)
+	if err != nil {
+		return
+	}
+	minitest.Equal(data, compareData)
+}
+
+func (jsn *expectJSONValue) NotEqual(expression string, data interface{}) {
+	v := jsn.get(expression)
+	if v == nil && data == nil {
+		minitest.Errorf("should not be %s", minitest.PrintValue(v))
+	}
+
+	if v == nil || data == nil {
+		minitest.NotEqual(data, v)
+	}
+
+	compareData, err := makeCompareable(v, data)
+	minitest.NoError(err)
+	minitest.NotEqual(data, compareData)
+}
+
+func (jsn *expectJSONValue) Contains(expression string, data interface{}) {
+	v := jsn.get(expression)
+	if v == nil && data == nil {
+		return
+	}
+
+	if !internal.Contains(v, data) {
+		minitest.Errorf("%s does not contain %s", minitest.PrintValue(v), minitest.PrintValue(data))
+	}
+}
+
+func (jsn *expectJSONValue) NotContains(expression string, data interface{}) {
+	v := jsn.get(expression)
+	if v == nil && data == nil {
+		minitest.Errorf("%s does contain %s", minitest.PrintValue(v), minitest.PrintValue(data))
+	}
+
+	if internal.Contains(v, data) {
+		minitest.Errorf("%s does contain %s", minitest.PrintValue(v), minitest.PrintValue(data))
+	}
+}
+
+func (jsn *expectJSONValue) Subset(expression string, data interface{}, opts ...JSONOption) {
+	compareJSON(data, jsn.get(expression), true, opts)
+}
+
+func (jsn *expectJSONValue) Matches(expression string, pattern interface{}) {
+	v, err := matchableString(jsn.get(expression))
+	minitest.NoError(err)
+	minitest.NoError(expectMatch(jsn.hit, pattern, v))
+}
+
+func (jsn *expectJSONValue) NotMatches(expression string, pattern interface{}) {
+	v, err := matchableString(jsn.get(expression))
+	minitest.NoError(err)
+	minitest.NoError(expectNoMatch(pattern, v))
+}
+
+func (jsn *expectJSONValue) Len(expression string, size int) {
+	minitest.Len(jsn.get(expression), size)
+}
+
+func (jsn *expectJSONValue) Exists(expression string) {
+	_, ok, err := jsn.lookup(expression)
+	minitest.NoError(err)
+	if !ok {
+		minitest.Errorf("%s does not exist", expression)
+	}
+}
+
+func (jsn *expectJSONValue) NotExists(expression string) {
+	v, ok, err := jsn.lookup(expression)
+	minitest.NoError(err)
+	if ok {
+		minitest.Errorf("%s does exist: %s", expression, minitest.PrintValue(v))
+	}
+}
+
+// jsonType returns the json type name of a decoded json value
+func jsonType(v interface{}) string {
+	if v == nil {
+		return "null"
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	return reflect.TypeOf(v).String()
+}
+
+func (jsn *expectJSONValue) Type(expression string, typ string) {
+	switch typ {
+	case "string", "number", "boolean", "object", "array", "null":
+	default:
+		minitest.Errorf("unknown type %q, use one of string, number, boolean, object, array or null", typ)
+	}
+	v, ok, err := jsn.lookup(expression)
+	minitest.NoError(err)
+	if !ok {
+		minitest.Errorf("%s does not exist", expression)
+	}
+	if actual := jsonType(v); actual != typ {
+		minitest.Errorf("%s should be of type %s, but is %s", minitest.PrintValue(v), typ, actual)
+	}
+}
+
+// compareOrdered compares the value with data, numbers are compared by their value, other values are converted into
+// the type of data first.
+// The result is -1 if the value is less than data, 0 if they are equal and +1 if the value is greater than data
+func compareOrdered(v, data interface{}) int {
+	if v == nil {
+		minitest.Errorf("unable to compare nil with %s", minitest.PrintValue(data))
+	}
+	if data == nil {
+		minitest.Errorf("unable to compare %s with nil", minitest.PrintValue(v))
+	}
+	if result, ok := compareNumbers(v, data); ok {
+		return result
+	}
+	compareData, err := makeCompareable(v, data)
+	minitest.NoError(err)
+
+	if t, ok := data.(time.Time); ok {
+		other := compareData.(time.Time)
+		switch {
+		case other.Before(t):
+			return -1
+		case other.After(t):
+			return 1
+		}
+		return 0
+	}
+
+	a := reflect.ValueOf(compareData)
+	b := reflect.ValueOf(data)
+	switch b.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareInts(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return compareUints(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareFloats(a.Float(), b.Float())
+	case reflect.String:
+		return compareStrings(a.String(), b.String())
+	}
+	minitest.Errorf("unable to compare %s, only numbers, strings and time.Time can be compared", minitest.PrintValue(data))
+	return 0
+}
+
+// compareNumbers compares two numbers without converting one into the type of the other, so fractions are not lost,
+// ok is false if one of the values is not a number
+func compareNumbers(a, b interface{}) (result int, ok bool) {
+	av, ok := numberValue(a)
+	if !ok {
+		return 0, false
+	}
+	bv, ok := numberValue(b)
+	if !ok {
+		return 0, false
+	}
+	// compare integers as integers, float64 can not represent all of them
+	switch {
+	case av.Kind() == reflect.Int64 && bv.Kind() == reflect.Int64:
+		return compareInts(av.Int(), bv.Int()), true
+	case av.Kind() == reflect.Uint64 && bv.Kind() == reflect.Uint64:
+		return compareUints(av.Uint(), bv.Uint()), true
+	}
+	return compareFloats(numberFloat(av), numberFloat(bv)), true
+}
+
+// numberValue returns the number as an int64, uint64 or float64 value, ok is false if v is not a number
+func numberValue(v interface{}) (reflect.Value, bool) {
+	if n, ok := v.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return reflect.ValueOf(i), true
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(f), true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.ValueOf(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return reflect.ValueOf(rv.Float()), true
+	}
+	return reflect.Value{}, false
+}
+
+func numberFloat(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint64:
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUints(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareStrings(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func (jsn *expectJSONValue) GreaterThan(expression string, data interface{}) {
+	v := jsn.get(expression)
+	if compareOrdered(v, data) <= 0 {
+		minitest.Errorf("%s is not greater than %s", minitest.PrintValue(v), minitest.PrintValue(data))
+	}
+}
+
+func (jsn *expectJSONValue) LessThan(expression string, data interface{}) {
+	v := jsn.get(expression)
+	if compareOrdered(v, data) >= 0 {
+		minitest.Errorf("%s is not less than %s", minitest.PrintValue(v), minitest.PrintValue(data))
+	}
+}
+
+func (jsn *expectJSONValue) GreaterOrEqualThan(expression string, data interface{}) {
+	v := jsn.get(expression)
+	if compareOrdered(v, data) < 0 {
+		minitest.Errorf("%s is not greater or equal than %s", minitest.PrintValue(v), minitest.PrintValue(data))
+	}
+}
+
+func (jsn *expectJSONValue) LessOrEqualThan(expression string, data interface{}) {
+	v := jsn.get(expression)
+	if compareOrdered(v, data) > 0 {
+		minitest.Errorf("%s is not less or equal than %s", minitest.PrintValue(v), minitest.PrintValue(data))
+	}
+}
+
+func (jsn *expectJSONValue) Between(expression string, min, max interface{}) {
+	v := jsn.get(expression)
+	if compareOrdered(v, min) < 0 || compareOrdered(v, max) > 0 {
+		minitest.Errorf("%s is not between %s and %s", minitest.PrintValue(v), minitest.PrintValue(min), minitest.PrintValue(max))
+	}
+}
+
+func (jsn *expectJSONValue) OneOf(expression string, values ...interface{}) {
+	v := jsn.get(expression)
+	for _, value := range values {
+		if v == nil || value == nil {
+			if v == nil && value == nil {
+				return
+			}
+			continue
+		}
+		if result, ok := compareNumbers(v, value); ok {
+			if result == 0 {
+				return
+			}
+			continue
+		}
+		compareData, err := makeCompareable(v, value)
+		if err != nil {
+			continue
+		}
+		if cmp.Equal(value, compareData) {
+			return
+		}
+	}
+	minitest.Errorf("%s is not one of %s", minitest.PrintValue(v), minitest.PrintValue(values))
+}
+
+// elements returns the elements of an array or the values of an object sorted by their keys
+func (jsn *expectJSONValue) elements(expression string) ([]string, []interface{}) {
+	v := jsn.get(expression)
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		names := make([]string, rv.Len())
+		values := make([]interface{}, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			names[i] = fmt.Sprintf("%d", i)
+			values[i] = rv.Index(i).Interface()
+		}
+		return names, values
+	case reflect.Map:
+		keys := rv.MapKeys()
+		names := make([]string, len(keys))
+		for i := range keys {
+			names[i] = fmt.Sprint(keys[i].Interface())
+		}
+		sort.Strings(names)
+		values := make([]interface{}, len(names))
+		for i := range names {
+			values[i] = rv.MapIndex(reflect.ValueOf(names[i])).Interface()
+		}
+		return names, values
+	}
+	minitest.Errorf("%s is not an array or an object", minitest.PrintValue(v))
+	return nil, nil
+}
+
+// runJSONValue runs fn for the value and returns the failure message if the assertions failed
+func runJSONValue(hit Hit, value interface{}, fn func(e IExpectJSONValue)) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s, ok := r.(string)
+			if !ok {
+				panic(r)
+			}
+			err = xerrors.New(s)
+		}
+	}()
+	fn(newExpectJSONValue(hit, value))
+	return nil
+}
+
+func elementPath(expression, name string) string {
+	if expression == "" {
+		return name
+	}
+	return expression + "." + name
+}
+
+func (jsn *expectJSONValue) Each(expression string, fn func(e IExpectJSONValue)) {
+	names, values := jsn.elements(expression)
+	for i := range values {
+		if err := runJSONValue(jsn.hit, values[i], fn); err != nil {
+			minitest.Errorf("%s: %s", elementPath(expression, names[i]), err.Error())
+		}
+	}
+}
+
+func (jsn *expectJSONValue) Any(expression string, fn func(e IExpectJSONValue)) {
+	names, values := jsn.elements(expression)
+	var errs []string
+	for i := range values {
+		err := runJSONValue(jsn.hit, values[i], fn)
+		if err == nil {
+			return
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", elementPath(expression, names[i]), err.Error()))
+	}
+	if len(errs) == 0 {
+		minitest.Errorf("%s has no elements", minitest.PrintValue(jsn.get(expression)))
+	}
+	minitest.Errorf("no element passed the assertions:\n%s", strings.Join(errs, "\n"))
+}
@@ -0,0 +1,384 @@
+package hit
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectJSONRPC provides assertions on a JSON-RPC 2.0 response.
+type IExpectJSONRPC interface {
+	IStep
+	// NoError expects the response to have no error member.
+	//
+	// Usage:
+	//     Expect().JSONRPC().NoError()
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/rpc"),
+	//         Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+	//         Expect().JSONRPC().NoError(),
+	//     )
+	NoError() IStep
+
+	// Result provides assertions on the result member, the expression is relative to the result member.
+	// Omit the expression to run assertions on the whole result member.
+	//
+	// Usage:
+	//     Expect().JSONRPC().Result("name").Equal("Joe")
+	//     Expect().JSONRPC().Result().Subset(map[string]interface{}{"name": "Joe"})
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/rpc"),
+	//         Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+	//         Expect().JSONRPC().Result("name").Equal("Joe"),
+	//     )
+	Result(expression ...string) IExpectJSONRPCMember
+
+	// Error provides assertions on the error member, omit the chain to expect that there is an error.
+	//
+	// Usage:
+	//     Expect().JSONRPC().Error()
+	//     Expect().JSONRPC().Error().Code(-32601)
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/rpc"),
+	//         Send().JSONRPC("user.delete", nil),
+	//         Expect().JSONRPC().Error().Code(-32601),
+	//     )
+	Error() IExpectJSONRPCError
+}
+
+// IExpectJSONRPCError provides assertions on the error member of a JSON-RPC 2.0 response
+type IExpectJSONRPCError interface {
+	IStep
+	// Code expects the error code to be the specified code.
+	//
+	// Usage:
+	//     Expect().JSONRPC().Error().Code(-32601)
+	Code(code int) IStep
+
+	// Message expects the error message to be equal to the specified message.
+	//
+	// Usage:
+	//     Expect().JSONRPC().Error().Message("Method not found")
+	Message(message string) IStep
+
+	// Data provides assertions on the data member of the error, the expression is relative to the data member.
+	//
+	// Usage:
+	//     Expect().JSONRPC().Error().Data("field").Equal("name")
+	Data(expression ...string) IExpectJSONRPCMember
+}
+
+// IExpectJSONRPCMember provides assertions on a member of a JSON-RPC 2.0 response.
+//
+// See IExpectBodyJSON for usage and examples.
+type IExpectJSONRPCMember interface {
+	IStep
+	// Equal expects the value to be equal to the specified value.
+	Equal(data interface{}, opts ...JSONOption) IStep
+	// NotEqual expects the value to be not equal to the specified value.
+	NotEqual(data interface{}) IStep
+	// Contains expects the value to contain the specified value.
+	Contains(data interface{}) IStep
+	// NotContains expects the value to not contain the specified value.
+	NotContains(data interface{}) IStep
+	// Subset expects the value to contain all object keys and values of the specified value.
+	Subset(data interface{}, opts ...JSONOption) IStep
+	// Len expects the string, array or object to have the specified length.
+	Len(size int) IStep
+	// Exists expects the value to exist.
+	Exists() IStep
+	// NotExists expects the value to not exist.
+	NotExists() IStep
+	// Type expects the value to be of the specified json type (string, number, boolean, object, array or null).
+	Type(typ string) IStep
+}
+
+// jsonRPCRequestID returns the id of the specified call, the id is read from the request body that was sent so custom
+// ids (e.g. strings) of requests that were not built with Send().JSONRPC() can be matched as well
+func jsonRPCRequestID(hit Hit, call int) interface{} {
+	calls, ok := hit.Request().Body().JSON().Get("").([]interface{})
+	if !ok {
+		calls = []interface{}{hit.Request().Body().JSON().Get("")}
+	}
+	if call < 0 || call >= len(calls) {
+		minitest.Errorf("request has no JSON-RPC call %d, it contains %d call(s)", call, len(calls))
+	}
+	request, ok := calls[call].(map[string]interface{})
+	if !ok {
+		minitest.Errorf("request is not a JSON-RPC request, expected a json object or array")
+	}
+	id, ok := request["id"]
+	if !ok || id == nil {
+		minitest.Errorf("JSON-RPC call %d is a notification, it has no id", call)
+	}
+	return id
+}
+
+// jsonRPCResponse decodes the response body and returns the response for the specified call,
+// in batch responses the response is matched by the id of the call
+func jsonRPCResponse(hit Hit, call int) map[string]interface{} {
+	id := jsonRPCRequestID(hit, call)
+	var response map[string]interface{}
+	switch v := newExpectJSONBody(hit).value.(type) {
+	case []interface{}:
+		for _, e := range v {
+			if m, ok := e.(map[string]interface{}); ok && reflect.DeepEqual(m["id"], id) {
+				response = m
+				break
+			}
+		}
+		if response == nil {
+			minitest.Errorf("batch response has no response for id %s", formatJSONRPCID(id))
+		}
+	case map[string]interface{}:
+		// a response without id is the answer to a request that could not be parsed
+		if v["id"] != nil && !reflect.DeepEqual(v["id"], id) {
+			minitest.Errorf("response id %s does not match the request id %s", formatJSONRPCID(v["id"]), formatJSONRPCID(id))
+		}
+		response = v
+	default:
+		minitest.Errorf("body is not a JSON-RPC response, expected a json object or array")
+	}
+	if response["jsonrpc"] != "2.0" {
+		minitest.Errorf("response is not a JSON-RPC 2.0 response, jsonrpc is %s", minitest.PrintValue(response["jsonrpc"]))
+	}
+	return response
+}
+
+// jsonRPCError returns the error member of the response, it fails if there is no error
+func jsonRPCError(response map[string]interface{}) map[string]interface{} {
+	e, ok := response["error"].(map[string]interface{})
+	if !ok {
+		minitest.Errorf("expected an error, but got none")
+	}
+	return e
+}
+
+// formatJSONRPCID formats a json id, numbers are printed without fraction and strings are quoted
+func formatJSONRPCID(id interface{}) string {
+	if s, ok := id.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprint(id)
+}
+
+func formatJSONRPCError(e interface{}) string {
+	if m, ok := e.(map[string]interface{}); ok {
+		return fmt.Sprintf("%v: %v", m["code"], m["message"])
+	}
+	return fmt.Sprint(e)
+}
+
+type expectJSONRPC struct {
+	expect    IExpect
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+	call      int
+}
+
+func newExpectJSONRPC(expect IExpect, cleanPath clearPath, call []int) IExpectJSONRPC {
+	rpc := &expectJSONRPC{
+		expect:    expect,
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+	if len(call) > 0 {
+		rpc.call = call[len(call)-1]
+	}
+	return rpc
+}
+
+func (rpc *expectJSONRPC) exec(hit Hit) error {
+	return rpc.trace.Format(hit.Description(), "unable to run Expect().JSONRPC() without a chain. Please use Expect().JSONRPC().Something")
+}
+
+func (*expectJSONRPC) when() StepTime {
+	return ExpectStep
+}
+
+func (rpc *expectJSONRPC) clearPath() clearPath {
+	return rpc.cleanPath
+}
+
+func (rpc *expectJSONRPC) NoError() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: rpc.clearPath().Push("NoError", nil),
+		Exec: func(hit Hit) error {
+			if e, ok := jsonRPCResponse(hit, rpc.call)["error"]; ok && e != nil {
+				minitest.Errorf("expected no error, but got %s", formatJSONRPCError(e))
+			}
+			return nil
+		},
+	}
+}
+
+func (rpc *expectJSONRPC) Result(expression ...string) IExpectJSONRPCMember {
+	args := make([]interface{}, len(expression))
+	for i := range expression {
+		args[i] = expression[i]
+	}
+	return newExpectJSONMember(
+		rpc.clearPath().Push("Result", args),
+		"Expect().JSONRPC().Result()",
+		joinExpression(expression),
+		func(hit Hit) interface{} {
+			response := jsonRPCResponse(hit, rpc.call)
+			if e, ok := response["error"]; ok && e != nil {
+				minitest.Errorf("expected a result, but got error %s", formatJSONRPCError(e))
+			}
+			return response["result"]
+		},
+	)
+}
+
+func (rpc *expectJSONRPC) Error() IExpectJSONRPCError {
+	return &expectJSONRPCError{
+		cleanPath: rpc.clearPath().Push("Error", nil),
+		trace:     ett.Prepare(),
+		call:      rpc.call,
+	}
+}
+
+type expectJSONRPCError struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+	call      int
+}
+
+func (e *expectJSONRPCError) exec(hit Hit) error {
+	// expect that there is an error
+	return (&hitStep{
+		Trace:     e.trace,
+		When:      ExpectStep,
+		ClearPath: e.cleanPath,
+		Exec: func(hit Hit) error {
+			jsonRPCError(jsonRPCResponse(hit, e.call))
+			return nil
+		},
+	}).exec(hit)
+}
+
+func (*expectJSONRPCError) when() StepTime {
+	return ExpectStep
+}
+
+func (e *expectJSONRPCError) clearPath() clearPath {
+	return e.cleanPath
+}
+
+func (e *expectJSONRPCError) Code(code int) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: e.clearPath().Push("Code", []interface{}{code}),
+		Exec: func(hit Hit) error {
+			compareData, err := makeCompareable(jsonRPCError(jsonRPCResponse(hit, e.call))["code"], code)
+			minitest.NoError(err)
+			minitest.Equal(code, compareData)
+			return nil
+		},
+	}
+}
+
+func (e *expectJSONRPCError) Message(message string) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: e.clearPath().Push("Message", []interface{}{message}),
+		Exec: func(hit Hit) error {
+			minitest.Equal(message, jsonRPCError(jsonRPCResponse(hit, e.call))["message"])
+			return nil
+		},
+	}
+}
+
+func (e *expectJSONRPCError) Data(expression ...string) IExpectJSONRPCMember {
+	args := make([]interface{}, len(expression))
+	for i := range expression {
+		args[i] = expression[i]
+	}
+	return newExpectJSONMember(
+		e.clearPath().Push("Data", args),
+		"Expect().JSONRPC().Error().Data()",
+		joinExpression(expression),
+		func(hit Hit) interface{} {
+			return jsonRPCError(jsonRPCResponse(hit, e.call))["data"]
+		},
+	)
+}
+
+type finalExpectJSONRPC struct {
+	IStep
+	message string
+}
+
+func (rpc *finalExpectJSONRPC) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(rpc.message)
+		},
+	}
+}
+
+func (rpc *finalExpectJSONRPC) NoError() IStep {
+	return rpc.fail()
+}
+
+func (rpc *finalExpectJSONRPC) Result(...string) IExpectJSONRPCMember {
+	return &finalExpectJSONMember{
+		rpc.fail(),
+		rpc.message,
+	}
+}
+
+func (rpc *finalExpectJSONRPC) Error() IExpectJSONRPCError {
+	return &finalExpectJSONRPCError{
+		rpc.fail(),
+		rpc.message,
+	}
+}
+
+type finalExpectJSONRPCError struct {
+	IStep
+	message string
+}
+
+func (e *finalExpectJSONRPCError) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(e.message)
+		},
+	}
+}
+
+func (e *finalExpectJSONRPCError) Code(int) IStep {
+	return e.fail()
+}
+
+func (e *finalExpectJSONRPCError) Message(string) IStep {
+	return e.fail()
+}
+
+func (e *finalExpectJSONRPCError) Data(...string) IExpectJSONRPCMember {
+	return &finalExpectJSONMember{
+		e.fail(),
+		e.message,
+	}
+}
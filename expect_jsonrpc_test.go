@@ -0,0 +1,240 @@
+package hit_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+)
+
+// JSONRPCServer answers user.get calls, all other methods fail with -32601,
+// batch responses are sent in reverse order
+func JSONRPCServer() *httptest.Server {
+	users := map[float64]string{1: "Joe", 2: "Alice"}
+	handle := func(call map[string]interface{}) map[string]interface{} {
+		response := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      call["id"],
+		}
+		if call["method"] != "user.get" {
+			response["error"] = map[string]interface{}{
+				"code":    -32601,
+				"message": "Method not found",
+				"data":    map[string]interface{}{"method": call["method"]},
+			}
+			return response
+		}
+		params, _ := call["params"].(map[string]interface{})
+		id, _ := params["id"].(float64)
+		response["result"] = map[string]interface{}{
+			"id":   id,
+			"name": users[id],
+		}
+		return response
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		var body interface{}
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			panic(err)
+		}
+		var response interface{}
+		switch v := body.(type) {
+		case []interface{}:
+			responses := make([]interface{}, len(v))
+			for i := range v {
+				responses[len(v)-1-i] = handle(v[i].(map[string]interface{}))
+			}
+			response = responses
+		case map[string]interface{}:
+			response = handle(v)
+		}
+		if err := json.NewEncoder(writer).Encode(response); err != nil {
+			panic(err)
+		}
+	}))
+}
+
+func TestSendJSONRPC(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+		Expect().Header("Content-Type").Equal("application/json"),
+		Expect().Body().JSON().Equal("", map[string]interface{}{
+			"jsonrpc": "2.0",
+			"method":  "user.get",
+			"params":  map[string]interface{}{"id": 1},
+			"id":      1,
+		}),
+	)
+
+	Test(t,
+		Post(s.URL),
+		Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+		Send().JSONRPC("ping", nil),
+		Expect().Body().JSON().Equal("", []interface{}{
+			map[string]interface{}{
+				"jsonrpc": "2.0",
+				"method":  "user.get",
+				"params":  map[string]interface{}{"id": 1},
+				"id":      1,
+			},
+			map[string]interface{}{
+				"jsonrpc": "2.0",
+				"method":  "ping",
+				"id":      2,
+			},
+		}),
+	)
+}
+
+func TestExpectJSONRPC(t *testing.T) {
+	s := JSONRPCServer()
+	defer s.Close()
+
+	t.Run("Result", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+			Expect().JSONRPC().NoError(),
+			Expect().JSONRPC().Result("name").Equal("Joe"),
+			Expect().JSONRPC().Result().Subset(map[string]interface{}{"id": 1}),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().JSONRPC("user.delete", nil),
+				Expect().JSONRPC().Result("name").Equal("Joe"),
+			),
+			PtrStr("expected a result, but got error -32601: Method not found"),
+		)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().JSONRPC("user.delete", nil),
+			Expect().JSONRPC().Error(),
+			Expect().JSONRPC().Error().Code(-32601),
+			Expect().JSONRPC().Error().Message("Method not found"),
+			Expect().JSONRPC().Error().Data("method").Equal("user.delete"),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().JSONRPC("user.delete", nil),
+				Expect().JSONRPC().NoError(),
+			),
+			PtrStr("expected no error, but got -32601: Method not found"),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+				Expect().JSONRPC().Error(),
+			),
+			PtrStr("expected an error, but got none"),
+		)
+	})
+
+	t.Run("Batch", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+			Send().JSONRPC("user.get", map[string]interface{}{"id": 2}),
+			Send().JSONRPC("user.delete", nil),
+			Expect().JSONRPC(0).Result("name").Equal("Joe"),
+			Expect().JSONRPC(1).Result("name").Equal("Alice"),
+			Expect().JSONRPC(2).Error().Code(-32601),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+				Send().JSONRPC("user.get", map[string]interface{}{"id": 2}),
+				Expect().JSONRPC(2).NoError(),
+			),
+			PtrStr("request has no JSON-RPC call 2, it contains 2 call(s)"),
+		)
+
+		incomplete := PrintJSONServer([]interface{}{
+			map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": true},
+		})
+		defer incomplete.Close()
+		ExpectError(t,
+			Do(
+				Post(incomplete.URL),
+				Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+				Send().JSONRPC("user.get", map[string]interface{}{"id": 2}),
+				Expect().JSONRPC(1).NoError(),
+			),
+			PtrStr("batch response has no response for id 2"),
+		)
+	})
+
+	t.Run("custom ids", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			Send().Body().JSON(map[string]interface{}{
+				"jsonrpc": "2.0",
+				"method":  "user.get",
+				"params":  map[string]interface{}{"id": 2},
+				"id":      "get-alice",
+			}),
+			Expect().JSONRPC().Result("name").Equal("Alice"),
+		)
+
+		Test(t,
+			Post(s.URL),
+			Send().Body().JSON([]interface{}{
+				map[string]interface{}{"jsonrpc": "2.0", "method": "user.get", "params": map[string]interface{}{"id": 1}, "id": "a"},
+				map[string]interface{}{"jsonrpc": "2.0", "method": "user.get", "params": map[string]interface{}{"id": 2}, "id": 42},
+			}),
+			Expect().JSONRPC(0).Result("name").Equal("Joe"),
+			Expect().JSONRPC(1).Result("name").Equal("Alice"),
+		)
+
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body().JSON(map[string]interface{}{"jsonrpc": "2.0", "method": "user.get"}),
+				Expect().JSONRPC().NoError(),
+			),
+			PtrStr("JSON-RPC call 0 is a notification, it has no id"),
+		)
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		invalid := PrintJSONServer(map[string]interface{}{"id": 1, "result": true})
+		defer invalid.Close()
+
+		ExpectError(t,
+			Do(
+				Post(invalid.URL),
+				Send().JSONRPC("user.get", nil),
+				Expect().JSONRPC().NoError(),
+			),
+			PtrStr("response is not a JSON-RPC 2.0 response, jsonrpc is nil"),
+		)
+
+		other := PrintJSONServer(map[string]interface{}{"jsonrpc": "2.0", "id": "other", "result": true})
+		defer other.Close()
+		ExpectError(t,
+			Do(
+				Post(other.URL),
+				Send().JSONRPC("user.get", nil),
+				Expect().JSONRPC().NoError(),
+			),
+			PtrStr(`response id "other" does not match the request id 1`),
+		)
+	})
+}
@@ -0,0 +1,290 @@
+package hit
+
+import (
+	"time"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectMessage provides assertions on the messages of the websocket connection that was established by WebSocket().
+//
+// Every assertion consumes one message, the steps run in order with the Send().Message() steps.
+type IExpectMessage interface {
+	IStep
+	// Equal expects the next message to be equal to the specified value.
+	//
+	// Usage:
+	//     Expect().Message().Equal("Hello World")
+	Equal(value interface{}) IStep
+
+	// NotEqual expects the next message to be not equal to the specified value.
+	//
+	// Usage:
+	//     Expect().Message().NotEqual("Hello World")
+	NotEqual(value interface{}) IStep
+
+	// Contains expects the next message to contain the specified value.
+	//
+	// Usage:
+	//     Expect().Message().Contains("Joe")
+	Contains(value interface{}) IStep
+
+	// NotContains expects the next message to not contain the specified value.
+	//
+	// Usage:
+	//     Expect().Message().NotContains("Alice")
+	NotContains(value interface{}) IStep
+
+	// Matches expects the next message to match the specified regular expression, the pattern can be a string or a
+	// *regexp.Regexp.
+	//
+	// Usage:
+	//     Expect().Message().Matches(`^Hello \w+$`)
+	Matches(pattern interface{}) IStep
+
+	// JSON decodes the next message as json, use the chained functions to run assertions on it.
+	//
+	// Usage:
+	//     Expect().Message().JSON().Equal("Name", "Joe")
+	JSON() IExpectMessageJSON
+
+	// Closed expects the connection to be closed by the server, if a code is specified the close code must match
+	// one of them.
+	//
+	// Usage:
+	//     Expect().Message().Closed()
+	//     Expect().Message().Closed(1000)
+	Closed(code ...int) IStep
+
+	// Timeout sets the maximum time to wait for each message, the default is DefaultWebSocketTimeout.
+	//
+	// Usage:
+	//     Expect().Message().Timeout(time.Second)
+	Timeout(timeout time.Duration) IStep
+}
+
+// IExpectMessageJSON provides assertions on a json websocket message.
+//
+// See IExpectBodyJSON for usage and examples.
+type IExpectMessageJSON interface {
+	IStep
+	// Equal expects the json message to be equal to the specified value.
+	Equal(expression string, data interface{}, opts ...JSONOption) IStep
+	// NotEqual expects the json message to be not equal to the specified value.
+	NotEqual(expression string, data interface{}) IStep
+	// Contains expects the json message to contain the specified value.
+	Contains(expression string, data interface{}) IStep
+	// NotContains expects the json message to not contain the specified value.
+	NotContains(expression string, data interface{}) IStep
+	// Subset expects the json message to contain all object keys and values of the specified value.
+	Subset(expression string, data interface{}, opts ...JSONOption) IStep
+	// Len expects the json string, array or object to have the specified length.
+	Len(expression string, size int) IStep
+	// Exists expects the json value to exist.
+	Exists(expression string) IStep
+	// NotExists expects the json value to not exist.
+	NotExists(expression string) IStep
+}
+
+// messageStep returns a step that waits for the next websocket message and runs fn with it
+func messageStep(cleanPath clearPath, fn func(hit Hit, message *WebSocketMessage)) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: cleanPath,
+		Exec: func(hit Hit) error {
+			message, err := hit.Response().WebSocket().Next()
+			if err != nil {
+				return err
+			}
+			fn(hit, message)
+			return nil
+		},
+	}
+}
+
+type expectMessage struct {
+	expect    IExpect
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newExpectMessage(expect IExpect, cleanPath clearPath, params []interface{}) IExpectMessage {
+	msg := &expectMessage{
+		expect:    expect,
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+
+	if param, ok := internal.GetLastArgument(params); ok {
+		// default action is Equal()
+		return &finalExpectMessage{
+			&hitStep{
+				Trace:     msg.trace,
+				When:      ExpectStep,
+				ClearPath: cleanPath,
+				Exec:      msg.Equal(param).exec,
+			},
+			"only usable with Expect().Message() not with Expect().Message(value)",
+		}
+	}
+	return msg
+}
+
+func (msg *expectMessage) exec(hit Hit) error {
+	// expect that there is a next message
+	return messageStep(msg.cleanPath, func(Hit, *WebSocketMessage) {}).exec(hit)
+}
+
+func (*expectMessage) when() StepTime {
+	return ExpectStep
+}
+
+func (msg *expectMessage) clearPath() clearPath {
+	return msg.cleanPath
+}
+
+func (msg *expectMessage) Equal(value interface{}) IStep {
+	return messageStep(msg.clearPath().Push("Equal", []interface{}{value}), func(hit Hit, message *WebSocketMessage) {
+		compareData, err := makeCompareable(string(message.Data), value)
+		minitest.NoError(err)
+		minitest.Equal(value, compareData)
+	})
+}
+
+func (msg *expectMessage) NotEqual(value interface{}) IStep {
+	return messageStep(msg.clearPath().Push("NotEqual", []interface{}{value}), func(hit Hit, message *WebSocketMessage) {
+		compareData, err := makeCompareable(string(message.Data), value)
+		minitest.NoError(err)
+		minitest.NotEqual(value, compareData)
+	})
+}
+
+func (msg *expectMessage) Contains(value interface{}) IStep {
+	return messageStep(msg.clearPath().Push("Contains", []interface{}{value}), func(hit Hit, message *WebSocketMessage) {
+		minitest.Contains(string(message.Data), value)
+	})
+}
+
+func (msg *expectMessage) NotContains(value interface{}) IStep {
+	return messageStep(msg.clearPath().Push("NotContains", []interface{}{value}), func(hit Hit, message *WebSocketMessage) {
+		minitest.NotContains(string(message.Data), value)
+	})
+}
+
+func (msg *expectMessage) Matches(pattern interface{}) IStep {
+	return messageStep(msg.clearPath().Push("Matches", []interface{}{patternArgument(pattern)}), func(hit Hit, message *WebSocketMessage) {
+		expectMatch(hit, pattern, string(message.Data))
+	})
+}
+
+func (msg *expectMessage) JSON() IExpectMessageJSON {
+	return newExpectJSONData(
+		msg.clearPath().Push("JSON", nil),
+		"Expect().Message().JSON()",
+		func(hit Hit) ([]byte, error) {
+			message, err := hit.Response().WebSocket().Next()
+			if err != nil {
+				return nil, err
+			}
+			return message.Data, nil
+		},
+	)
+}
+
+func (msg *expectMessage) Closed(code ...int) IStep {
+	args := make([]interface{}, len(code))
+	for i := range code {
+		args[i] = code[i]
+	}
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: msg.clearPath().Push("Closed", args),
+		Exec: func(hit Hit) error {
+			message, err := hit.Response().WebSocket().Next()
+			if err == nil {
+				return xerrors.Errorf("expected websocket connection to be closed, but received message %q", string(message.Data))
+			}
+			closeCode, ok := webSocketCloseCode(err)
+			if !ok {
+				return err
+			}
+			if len(code) == 0 {
+				return nil
+			}
+			for _, c := range code {
+				if c == closeCode {
+					return nil
+				}
+			}
+			return xerrors.Errorf("expected websocket close code to be one of %v, but was %d", code, closeCode)
+		},
+	}
+}
+
+func (msg *expectMessage) Timeout(timeout time.Duration) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      BeforeExpectStep,
+		ClearPath: msg.clearPath().Push("Timeout", []interface{}{timeout}),
+		Exec: func(hit Hit) error {
+			hit.Response().WebSocket().SetTimeout(timeout)
+			return nil
+		},
+	}
+}
+
+type finalExpectMessage struct {
+	IStep
+	message string
+}
+
+func (msg *finalExpectMessage) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(msg.message)
+		},
+	}
+}
+
+func (msg *finalExpectMessage) Equal(interface{}) IStep {
+	return msg.fail()
+}
+
+func (msg *finalExpectMessage) NotEqual(interface{}) IStep {
+	return msg.fail()
+}
+
+func (msg *finalExpectMessage) Contains(interface{}) IStep {
+	return msg.fail()
+}
+
+func (msg *finalExpectMessage) NotContains(interface{}) IStep {
+	return msg.fail()
+}
+
+func (msg *finalExpectMessage) Matches(interface{}) IStep {
+	return msg.fail()
+}
+
+func (msg *finalExpectMessage) JSON() IExpectMessageJSON {
+	return &finalExpectJSONData{
+		msg.fail(),
+		msg.message,
+	}
+}
+
+func (msg *finalExpectMessage) Closed(...int) IStep {
+	return msg.fail()
+}
+
+func (msg *finalExpectMessage) Timeout(time.Duration) IStep {
+	return msg.fail()
+}
@@ -0,0 +1,113 @@
+package hit
+
+import (
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectProto provides assertions on the protocol of the response, e.g. HTTP/1.1 or HTTP/2.0
+type IExpectProto interface {
+	IStep
+	// Equal expects the protocol to be equal to the specified protocol.
+	//
+	// Usage:
+	//     Expect().Proto().Equal("HTTP/2.0")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Proto().Equal("HTTP/2.0"),
+	//     )
+	Equal(proto string) IStep
+
+	// NotEqual expects the protocol to be not equal to the specified protocol.
+	//
+	// Usage:
+	//     Expect().Proto().NotEqual("HTTP/1.0")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Proto().NotEqual("HTTP/1.0"),
+	//     )
+	NotEqual(proto string) IStep
+}
+
+type expectProto struct {
+	expect    IExpect
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newExpectProto(expect IExpect, cleanPath clearPath) IExpectProto {
+	return &expectProto{
+		expect:    expect,
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (p *expectProto) exec(hit Hit) error {
+	return p.trace.Format(hit.Description(), "unable to run Expect().Proto() without a chain. Please use Expect().Proto().Something")
+}
+
+func (*expectProto) when() StepTime {
+	return ExpectStep
+}
+
+func (p *expectProto) clearPath() clearPath {
+	return p.cleanPath
+}
+
+func (p *expectProto) Equal(proto string) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: p.clearPath().Push("Equal", []interface{}{proto}),
+		Exec: func(hit Hit) error {
+			if actual := hit.Response().Proto; actual != proto {
+				minitest.Errorf("expected protocol to be %s, but was %s", minitest.PrintValue(proto), minitest.PrintValue(actual))
+			}
+			return nil
+		},
+	}
+}
+
+func (p *expectProto) NotEqual(proto string) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: p.clearPath().Push("NotEqual", []interface{}{proto}),
+		Exec: func(hit Hit) error {
+			if hit.Response().Proto == proto {
+				minitest.Errorf("expected protocol not to be %s", minitest.PrintValue(proto))
+			}
+			return nil
+		},
+	}
+}
+
+type finalExpectProto struct {
+	IStep
+	message string
+}
+
+func (p *finalExpectProto) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(p.message)
+		},
+	}
+}
+
+func (p *finalExpectProto) Equal(string) IStep {
+	return p.fail()
+}
+
+func (p *finalExpectProto) NotEqual(string) IStep {
+	return p.fail()
+}
@@ -0,0 +1,245 @@
+package hit
+
+import (
+	"net/http"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectRedirect provides assertions on the last redirect of the request, omit the chain to expect that there was a
+// redirect.
+//
+// The last redirect is the response itself if it is a redirect (e.g. because FollowRedirects(false) was used),
+// otherwise the last redirect that was followed.
+type IExpectRedirect interface {
+	IStep
+	// To expects the redirect to point to the specified location, relative locations are resolved against the url
+	// of the request that was redirected.
+	//
+	// Usage:
+	//     Expect().Redirect().To("/login")
+	//     Expect().Redirect().To("https://example.com/login")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/admin"),
+	//         FollowRedirects(false),
+	//         Expect().Redirect().To("/login"),
+	//     )
+	To(location string) IStep
+
+	// Status expects the redirect to have the specified status code.
+	//
+	// Usage:
+	//     Expect().Redirect().Status(http.StatusMovedPermanently)
+	Status(code int) IStep
+}
+
+// IExpectRedirects provides assertions on the redirects that were followed.
+type IExpectRedirects interface {
+	IStep
+	// Len expects the specified amount of redirects to be followed.
+	//
+	// Usage:
+	//     Expect().Redirects().Len(2)
+	Len(size int) IStep
+
+	// Each calls the specified function for the response of every redirect that was followed.
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Redirects().Each(func(response *http.Response) {
+	//             fmt.Println(response.StatusCode, response.Header.Get("Location"))
+	//         }),
+	//     )
+	Each(fn func(response *http.Response)) IStep
+}
+
+func isRedirect(response *http.Response) bool {
+	return response.StatusCode >= 300 && response.StatusCode < 400 && response.Header.Get("Location") != ""
+}
+
+// lastRedirect returns the response itself if it is a redirect, otherwise the last redirect that was followed
+func lastRedirect(hit Hit) *http.Response {
+	if isRedirect(hit.Response().Response) {
+		return hit.Response().Response
+	}
+	redirects := hit.Response().Redirects()
+	if len(redirects) == 0 {
+		minitest.Errorf("expected a redirect, but got status %d without a redirect", hit.Response().StatusCode)
+	}
+	return redirects[len(redirects)-1]
+}
+
+type expectRedirect struct {
+	expect    IExpect
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newExpectRedirect(expect IExpect, cleanPath clearPath) IExpectRedirect {
+	return &expectRedirect{
+		expect:    expect,
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (r *expectRedirect) exec(hit Hit) error {
+	// expect that there was a redirect
+	return (&hitStep{
+		Trace:     r.trace,
+		When:      ExpectStep,
+		ClearPath: r.cleanPath,
+		Exec: func(hit Hit) error {
+			lastRedirect(hit)
+			return nil
+		},
+	}).exec(hit)
+}
+
+func (*expectRedirect) when() StepTime {
+	return ExpectStep
+}
+
+func (r *expectRedirect) clearPath() clearPath {
+	return r.cleanPath
+}
+
+func (r *expectRedirect) To(location string) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: r.clearPath().Push("To", []interface{}{location}),
+		Exec: func(hit Hit) error {
+			response := lastRedirect(hit)
+			actual, err := response.Location()
+			minitest.NoError(err)
+			expected, err := response.Request.URL.Parse(location)
+			minitest.NoError(err)
+			if expected.String() != actual.String() {
+				minitest.Errorf("expected redirect to %s, but was redirected to %s", expected.String(), actual.String())
+			}
+			return nil
+		},
+	}
+}
+
+func (r *expectRedirect) Status(code int) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: r.clearPath().Push("Status", []interface{}{code}),
+		Exec: func(hit Hit) error {
+			if actual := lastRedirect(hit).StatusCode; actual != code {
+				minitest.Errorf("expected redirect status to be %d, but was %d", code, actual)
+			}
+			return nil
+		},
+	}
+}
+
+type expectRedirects struct {
+	expect    IExpect
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newExpectRedirects(expect IExpect, cleanPath clearPath) IExpectRedirects {
+	return &expectRedirects{
+		expect:    expect,
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (r *expectRedirects) exec(hit Hit) error {
+	return r.trace.Format(hit.Description(), "unable to run Expect().Redirects() without a chain. Please use Expect().Redirects().Something")
+}
+
+func (*expectRedirects) when() StepTime {
+	return ExpectStep
+}
+
+func (r *expectRedirects) clearPath() clearPath {
+	return r.cleanPath
+}
+
+func (r *expectRedirects) Len(size int) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: r.clearPath().Push("Len", []interface{}{size}),
+		Exec: func(hit Hit) error {
+			if actual := len(hit.Response().Redirects()); actual != size {
+				minitest.Errorf("expected %d redirect(s), but got %d", size, actual)
+			}
+			return nil
+		},
+	}
+}
+
+func (r *expectRedirects) Each(fn func(response *http.Response)) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: r.clearPath().Push("Each", []interface{}{fn}),
+		Exec: func(hit Hit) error {
+			for _, response := range hit.Response().Redirects() {
+				fn(response)
+			}
+			return nil
+		},
+	}
+}
+
+type finalExpectRedirect struct {
+	IStep
+	message string
+}
+
+func (r *finalExpectRedirect) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(r.message)
+		},
+	}
+}
+
+func (r *finalExpectRedirect) To(string) IStep {
+	return r.fail()
+}
+
+func (r *finalExpectRedirect) Status(int) IStep {
+	return r.fail()
+}
+
+type finalExpectRedirects struct {
+	IStep
+	message string
+}
+
+func (r *finalExpectRedirects) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(r.message)
+		},
+	}
+}
+
+func (r *finalExpectRedirects) Len(int) IStep {
+	return r.fail()
+}
+
+func (r *finalExpectRedirects) Each(func(*http.Response)) IStep {
+	return r.fail()
+}
@@ -0,0 +1,135 @@
+package hit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+// RedirectServer redirects /a to /b to /c, /admin to /login and /loop to itself
+func RedirectServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/a", func(writer http.ResponseWriter, request *http.Request) {
+		http.Redirect(writer, request, "/b", http.StatusFound)
+	})
+	mux.HandleFunc("/b", func(writer http.ResponseWriter, request *http.Request) {
+		http.Redirect(writer, request, "/c", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/c", func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("done"))
+	})
+	mux.HandleFunc("/admin", func(writer http.ResponseWriter, request *http.Request) {
+		http.Redirect(writer, request, "/login", http.StatusFound)
+	})
+	mux.HandleFunc("/loop", func(writer http.ResponseWriter, request *http.Request) {
+		http.Redirect(writer, request, "/loop", http.StatusFound)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestRedirects(t *testing.T) {
+	s := RedirectServer()
+	defer s.Close()
+
+	t.Run("follow", func(t *testing.T) {
+		var statusCodes []int
+		Test(t,
+			Get(s.URL+"/a"),
+			Expect().Status(http.StatusOK),
+			Expect().Body("done"),
+			Expect().Redirect(),
+			Expect().Redirect().To("/c"),
+			Expect().Redirect().To(s.URL+"/c"),
+			Expect().Redirect().Status(http.StatusMovedPermanently),
+			Expect().Redirects().Len(2),
+			Expect().Redirects().Each(func(response *http.Response) {
+				statusCodes = append(statusCodes, response.StatusCode)
+			}),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, "/b", hit.Response().Redirects()[0].Header.Get("Location"))
+			}),
+		)
+		require.Equal(t, []int{http.StatusFound, http.StatusMovedPermanently}, statusCodes)
+	})
+
+	t.Run("do not follow", func(t *testing.T) {
+		Test(t,
+			Get(s.URL+"/admin"),
+			FollowRedirects(false),
+			Expect().Status(http.StatusFound),
+			Expect().Redirect().To("/login"),
+			Expect().Redirect().Status(http.StatusFound),
+			Expect().Redirects().Len(0),
+		)
+
+		ExpectError(t,
+			Do(
+				Get(s.URL+"/admin"),
+				FollowRedirects(false),
+				Expect().Redirect().To("/home"),
+			),
+			PtrStr("expected redirect to "+s.URL+"/home, but was redirected to "+s.URL+"/login"),
+		)
+	})
+
+	t.Run("max redirects", func(t *testing.T) {
+		Test(t,
+			Get(s.URL+"/a"),
+			MaxRedirects(2),
+			Expect().Body("done"),
+		)
+
+		err := Do(
+			Get(s.URL+"/a"),
+			MaxRedirects(1),
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "stopped after 1 redirects")
+
+		err = Do(
+			Get(s.URL + "/loop"),
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "stopped after 10 redirects")
+	})
+
+	t.Run("client is not modified", func(t *testing.T) {
+		var client http.Client
+		Test(t,
+			Get(s.URL+"/a"),
+			HTTPClient(&client),
+			FollowRedirects(false),
+			Expect().Status(http.StatusFound),
+		)
+		require.Nil(t, client.CheckRedirect)
+	})
+
+	t.Run("no redirect", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL+"/c"),
+				Expect().Redirect(),
+			),
+			PtrStr("expected a redirect, but got status 200 without a redirect"),
+		)
+
+		ExpectError(t,
+			Do(
+				Get(s.URL+"/a"),
+				Expect().Redirects().Len(1),
+			),
+			PtrStr("expected 1 redirect(s), but got 2"),
+		)
+
+		ExpectError(t,
+			Do(
+				Get(s.URL+"/a"),
+				Expect().Redirects(),
+			),
+			PtrStr("unable to run Expect().Redirects() without a chain. Please use Expect().Redirects().Something"),
+		)
+	})
+}
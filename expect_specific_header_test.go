@@ -202,3 +202,39 @@ func TestExpectSpecificHeader_NotOneOf(t *testing.T) {
 		nil, nil, nil, PtrStr(`} should not contain "Hello"`),
 	)
 }
+
+func TestExpectHeadersSpecificHeader_Matches(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Location", "/users/10")
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Expect().Header("Location").Matches(`^/users/(?P<id>\d+)$`),
+		Expect().Header("Location").NotMatches(`groups`),
+		Expect().Custom(func(hit Hit) {
+			if hit.Var("id") != "10" {
+				t.Errorf("expected id to be 10, got %q", hit.Var("id"))
+			}
+		}),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Expect().Header("Location").Matches(`^/groups/`),
+		),
+		PtrStr(`"/users/10" does not match ^/groups/`),
+	)
+
+	ExpectError(t,
+		Do(
+			Post(s.URL),
+			Expect().Header("Location").NotMatches(`users`),
+		),
+		PtrStr(`"/users/10" should not match users`),
+	)
+}
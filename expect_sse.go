@@ -0,0 +1,479 @@
+package hit
+
+import (
+	"time"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectSSE provides assertions on a server-sent event stream (text/event-stream).
+//
+// The events are read as they arrive, every Next() and Event() step consumes one event in the order the steps are
+// defined.
+type IExpectSSE interface {
+	IStep
+	// Next waits for the next event, use the chained functions to run assertions on it.
+	//
+	// Usage:
+	//     Expect().SSE().Next()                                  // expect that there is a next event
+	//     Expect().SSE().Next().Event().Equal("update")
+	//     Expect().SSE().Next().Data().JSON().Equal("Name", "Joe")
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/events"),
+	//         Expect().SSE().Next().Data().Equal("connected"),
+	//         Expect().SSE().Next().Data().JSON().Equal("Name", "Joe"),
+	//     )
+	Next() IExpectSSEEvent
+
+	// Event waits for the next event and calls the specified function with it.
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/events"),
+	//         Expect().SSE().Event(func(e SSEEvent) {
+	//             fmt.Println(e.ID, e.Data)
+	//         }),
+	//     )
+	Event(fn func(e SSEEvent)) IStep
+
+	// Timeout sets the maximum time to wait for each event, the default is DefaultSSETimeout.
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/events"),
+	//         Expect().SSE().Timeout(time.Second),
+	//         Expect().SSE().Next().Data().Equal("connected"),
+	//     )
+	Timeout(timeout time.Duration) IStep
+
+	// MaxEvents sets the maximum amount of events that can be read from the stream.
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com/events"),
+	//         Expect().SSE().MaxEvents(1),
+	//         Expect().SSE().Next().Data().Equal("connected"),
+	//     )
+	MaxEvents(maxEvents int) IStep
+}
+
+// IExpectSSEEvent provides assertions on the next server-sent event
+type IExpectSSEEvent interface {
+	IStep
+	// ID provides assertions on the id field of the event.
+	//
+	// Usage:
+	//     Expect().SSE().Next().ID().Equal("1")
+	ID() IExpectSSEValue
+
+	// Event provides assertions on the event field of the event, if the event has no event field it is "message".
+	//
+	// Usage:
+	//     Expect().SSE().Next().Event().Equal("update")
+	Event() IExpectSSEValue
+
+	// Data provides assertions on the data of the event.
+	//
+	// Usage:
+	//     Expect().SSE().Next().Data().Equal("Hello World")
+	//     Expect().SSE().Next().Data().JSON().Equal("Name", "Joe")
+	Data() IExpectSSEData
+
+	// Retry expects the retry field of the event to be the specified duration.
+	//
+	// Usage:
+	//     Expect().SSE().Next().Retry(time.Second)
+	Retry(retry time.Duration) IStep
+}
+
+// IExpectSSEValue provides assertions on a field of a server-sent event
+type IExpectSSEValue interface {
+	IStep
+	// Equal expects the value to be equal to the specified value.
+	//
+	// Usage:
+	//     Expect().SSE().Next().Event().Equal("update")
+	Equal(value interface{}) IStep
+
+	// NotEqual expects the value to be not equal to the specified value.
+	//
+	// Usage:
+	//     Expect().SSE().Next().Event().NotEqual("error")
+	NotEqual(value interface{}) IStep
+
+	// Contains expects the value to contain the specified value.
+	//
+	// Usage:
+	//     Expect().SSE().Next().Data().Contains("Joe")
+	Contains(value interface{}) IStep
+
+	// NotContains expects the value to not contain the specified value.
+	//
+	// Usage:
+	//     Expect().SSE().Next().Data().NotContains("Alice")
+	NotContains(value interface{}) IStep
+
+	// Matches expects the value to match the specified regular expression, the pattern can be a string or a
+	// *regexp.Regexp.
+	//
+	// Usage:
+	//     Expect().SSE().Next().ID().Matches(`^\d+$`)
+	Matches(pattern interface{}) IStep
+}
+
+// IExpectSSEData provides assertions on the data of a server-sent event
+type IExpectSSEData interface {
+	IExpectSSEValue
+
+	// JSON decodes the data as json, use the chained functions to run assertions on it.
+	//
+	// Usage:
+	//     Expect().SSE().Next().Data().JSON().Equal("Name", "Joe")
+	JSON() IExpectSSEDataJSON
+}
+
+// IExpectSSEDataJSON provides assertions on the json data of a server-sent event.
+//
+// See IExpectBodyJSON for usage and examples.
+type IExpectSSEDataJSON interface {
+	IStep
+	// Equal expects the json data to be equal to the specified value.
+	Equal(expression string, data interface{}, opts ...JSONOption) IStep
+	// NotEqual expects the json data to be not equal to the specified value.
+	NotEqual(expression string, data interface{}) IStep
+	// Contains expects the json data to contain the specified value.
+	Contains(expression string, data interface{}) IStep
+	// NotContains expects the json data to not contain the specified value.
+	NotContains(expression string, data interface{}) IStep
+	// Subset expects the json data to contain all object keys and values of the specified value.
+	Subset(expression string, data interface{}, opts ...JSONOption) IStep
+	// Len expects the json string, array or object to have the specified length.
+	Len(expression string, size int) IStep
+	// Exists expects the json value to exist.
+	Exists(expression string) IStep
+	// NotExists expects the json value to not exist.
+	NotExists(expression string) IStep
+}
+
+// sseStep returns a step that waits for the next event and runs fn with it
+func sseStep(trace *errortrace.ErrorTrace, cleanPath clearPath, fn func(hit Hit, event *SSEEvent)) IStep {
+	return &hitStep{
+		Trace:     trace,
+		When:      ExpectStep,
+		ClearPath: cleanPath,
+		Exec: func(hit Hit) error {
+			event, err := hit.Response().SSE().Next()
+			if err != nil {
+				return err
+			}
+			fn(hit, event)
+			return nil
+		},
+	}
+}
+
+type expectSSE struct {
+	expect    IExpect
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newExpectSSE(expect IExpect, cleanPath clearPath) IExpectSSE {
+	return &expectSSE{
+		expect:    expect,
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (sse *expectSSE) exec(hit Hit) error {
+	return sse.trace.Format(hit.Description(), "unable to run Expect().SSE() without a chain. Please use Expect().SSE().Something")
+}
+
+func (*expectSSE) when() StepTime {
+	return ExpectStep
+}
+
+func (sse *expectSSE) clearPath() clearPath {
+	return sse.cleanPath
+}
+
+func (sse *expectSSE) Next() IExpectSSEEvent {
+	return newExpectSSEEvent(sse.clearPath().Push("Next", nil))
+}
+
+func (sse *expectSSE) Event(fn func(e SSEEvent)) IStep {
+	return sseStep(ett.Prepare(), sse.clearPath().Push("Event", []interface{}{fn}), func(hit Hit, event *SSEEvent) {
+		fn(*event)
+	})
+}
+
+func (sse *expectSSE) Timeout(timeout time.Duration) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      BeforeExpectStep,
+		ClearPath: sse.clearPath().Push("Timeout", []interface{}{timeout}),
+		Exec: func(hit Hit) error {
+			hit.Response().SSE().SetTimeout(timeout)
+			return nil
+		},
+	}
+}
+
+func (sse *expectSSE) MaxEvents(maxEvents int) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      BeforeExpectStep,
+		ClearPath: sse.clearPath().Push("MaxEvents", []interface{}{maxEvents}),
+		Exec: func(hit Hit) error {
+			hit.Response().SSE().SetMaxEvents(maxEvents)
+			return nil
+		},
+	}
+}
+
+type expectSSEEvent struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newExpectSSEEvent(cleanPath clearPath) IExpectSSEEvent {
+	return &expectSSEEvent{
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (e *expectSSEEvent) exec(hit Hit) error {
+	// expect that there is a next event
+	return sseStep(e.trace, e.cleanPath, func(Hit, *SSEEvent) {}).exec(hit)
+}
+
+func (*expectSSEEvent) when() StepTime {
+	return ExpectStep
+}
+
+func (e *expectSSEEvent) clearPath() clearPath {
+	return e.cleanPath
+}
+
+func (e *expectSSEEvent) ID() IExpectSSEValue {
+	return newExpectSSEValue(e.clearPath().Push("ID", nil), func(event *SSEEvent) string {
+		return event.ID
+	})
+}
+
+func (e *expectSSEEvent) Event() IExpectSSEValue {
+	return newExpectSSEValue(e.clearPath().Push("Event", nil), func(event *SSEEvent) string {
+		return event.Event
+	})
+}
+
+func (e *expectSSEEvent) Data() IExpectSSEData {
+	return newExpectSSEValue(e.clearPath().Push("Data", nil), func(event *SSEEvent) string {
+		return event.Data
+	})
+}
+
+func (e *expectSSEEvent) Retry(retry time.Duration) IStep {
+	return sseStep(ett.Prepare(), e.clearPath().Push("Retry", []interface{}{retry}), func(hit Hit, event *SSEEvent) {
+		minitest.Equal(retry, event.Retry)
+	})
+}
+
+type expectSSEValue struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+	getter    func(event *SSEEvent) string
+}
+
+func newExpectSSEValue(cleanPath clearPath, getter func(event *SSEEvent) string) *expectSSEValue {
+	return &expectSSEValue{
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+		getter:    getter,
+	}
+}
+
+func (v *expectSSEValue) exec(hit Hit) error {
+	return v.trace.Format(hit.Description(), "unable to run Expect().SSE().Next().ID(), Expect().SSE().Next().Event() or Expect().SSE().Next().Data() without a chain. Please use Expect().SSE().Next().Data().Something")
+}
+
+func (*expectSSEValue) when() StepTime {
+	return ExpectStep
+}
+
+func (v *expectSSEValue) clearPath() clearPath {
+	return v.cleanPath
+}
+
+func (v *expectSSEValue) Equal(value interface{}) IStep {
+	return sseStep(ett.Prepare(), v.clearPath().Push("Equal", []interface{}{value}), func(hit Hit, event *SSEEvent) {
+		compareData, err := makeCompareable(v.getter(event), value)
+		minitest.NoError(err)
+		minitest.Equal(value, compareData)
+	})
+}
+
+func (v *expectSSEValue) NotEqual(value interface{}) IStep {
+	return sseStep(ett.Prepare(), v.clearPath().Push("NotEqual", []interface{}{value}), func(hit Hit, event *SSEEvent) {
+		compareData, err := makeCompareable(v.getter(event), value)
+		minitest.NoError(err)
+		minitest.NotEqual(value, compareData)
+	})
+}
+
+func (v *expectSSEValue) Contains(value interface{}) IStep {
+	return sseStep(ett.Prepare(), v.clearPath().Push("Contains", []interface{}{value}), func(hit Hit, event *SSEEvent) {
+		minitest.Contains(v.getter(event), value)
+	})
+}
+
+func (v *expectSSEValue) NotContains(value interface{}) IStep {
+	return sseStep(ett.Prepare(), v.clearPath().Push("NotContains", []interface{}{value}), func(hit Hit, event *SSEEvent) {
+		minitest.NotContains(v.getter(event), value)
+	})
+}
+
+func (v *expectSSEValue) Matches(pattern interface{}) IStep {
+	return sseStep(ett.Prepare(), v.clearPath().Push("Matches", []interface{}{patternArgument(pattern)}), func(hit Hit, event *SSEEvent) {
+		expectMatch(hit, pattern, v.getter(event))
+	})
+}
+
+func (v *expectSSEValue) JSON() IExpectSSEDataJSON {
+	return newExpectJSONData(
+		v.clearPath().Push("JSON", nil),
+		"Expect().SSE().Next().Data().JSON()",
+		func(hit Hit) ([]byte, error) {
+			event, err := hit.Response().SSE().Next()
+			if err != nil {
+				return nil, err
+			}
+			return []byte(v.getter(event)), nil
+		},
+	)
+}
+
+type finalExpectSSE struct {
+	IStep
+	message string
+}
+
+func (sse *finalExpectSSE) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(sse.message)
+		},
+	}
+}
+
+func (sse *finalExpectSSE) Next() IExpectSSEEvent {
+	return &finalExpectSSEEvent{
+		sse.fail(),
+		sse.message,
+	}
+}
+
+func (sse *finalExpectSSE) Event(func(e SSEEvent)) IStep {
+	return sse.fail()
+}
+
+func (sse *finalExpectSSE) Timeout(time.Duration) IStep {
+	return sse.fail()
+}
+
+func (sse *finalExpectSSE) MaxEvents(int) IStep {
+	return sse.fail()
+}
+
+type finalExpectSSEEvent struct {
+	IStep
+	message string
+}
+
+func (e *finalExpectSSEEvent) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(e.message)
+		},
+	}
+}
+
+func (e *finalExpectSSEEvent) ID() IExpectSSEValue {
+	return &finalExpectSSEValue{
+		e.fail(),
+		e.message,
+	}
+}
+
+func (e *finalExpectSSEEvent) Event() IExpectSSEValue {
+	return &finalExpectSSEValue{
+		e.fail(),
+		e.message,
+	}
+}
+
+func (e *finalExpectSSEEvent) Data() IExpectSSEData {
+	return &finalExpectSSEValue{
+		e.fail(),
+		e.message,
+	}
+}
+
+func (e *finalExpectSSEEvent) Retry(time.Duration) IStep {
+	return e.fail()
+}
+
+type finalExpectSSEValue struct {
+	IStep
+	message string
+}
+
+func (v *finalExpectSSEValue) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(v.message)
+		},
+	}
+}
+
+func (v *finalExpectSSEValue) Equal(interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectSSEValue) NotEqual(interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectSSEValue) Contains(interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectSSEValue) NotContains(interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectSSEValue) Matches(interface{}) IStep {
+	return v.fail()
+}
+
+func (v *finalExpectSSEValue) JSON() IExpectSSEDataJSON {
+	return &finalExpectJSONData{
+		v.fail(),
+		v.message,
+	}
+}
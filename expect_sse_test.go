@@ -0,0 +1,162 @@
+package hit_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+// SSEServer sends the specified chunks as an event stream and keeps the connection open until the client disconnects
+func SSEServer(chunks ...string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "text/event-stream")
+		writer.WriteHeader(http.StatusOK)
+		flusher := writer.(http.Flusher)
+		for _, chunk := range chunks {
+			_, _ = fmt.Fprint(writer, chunk)
+			flusher.Flush()
+			time.Sleep(time.Millisecond)
+		}
+		<-request.Context().Done()
+	}))
+}
+
+func TestExpectSSE(t *testing.T) {
+	s := SSEServer(
+		": welcome\n\n",
+		"data: connected\n\n",
+		"id: 1\nevent: user\nretry: 1500\ndata: {\"Name\": \"Joe\",\ndata:  \"ID\": 10}\n\n",
+		"id: 2\r\nevent: user\r\ndata: {\"Name\": \"Alice\", \"ID\": 11}\r\n\r\n",
+	)
+	defer s.Close()
+
+	Test(t,
+		Get(s.URL),
+		Expect().Header("Content-Type").Equal("text/event-stream"),
+		Expect().SSE().Timeout(time.Second),
+		Expect().SSE().Next().Data().Equal("connected"),
+		Expect().SSE().Event(func(e SSEEvent) {
+			require.Equal(t, SSEEvent{
+				ID:    "1",
+				Event: "user",
+				Data:  "{\"Name\": \"Joe\",\n \"ID\": 10}",
+				Retry: 1500 * time.Millisecond,
+			}, e)
+		}),
+		Expect().SSE().Next().Data().JSON().Subset("", map[string]interface{}{"Name": "Alice"}),
+	)
+
+	Test(t,
+		Get(s.URL),
+		Expect().SSE().Next().Event().Equal("message"),
+		Expect().SSE().Next().ID().Equal(1),
+		Expect().SSE().Next().Event().Equal("user"),
+	)
+
+	Test(t,
+		Get(s.URL),
+		Expect().SSE().Next(),
+		Expect().SSE().Next().Retry(1500*time.Millisecond),
+		Expect().SSE().Next().Data().JSON().Equal("ID", 11),
+	)
+
+	Test(t,
+		Get(s.URL),
+		Expect().SSE().Next().Data().NotEqual("disconnected"),
+		Expect().SSE().Next().Data().Contains("Joe"),
+		Expect().SSE().Next().ID().Matches(`^\d+$`),
+	)
+}
+
+func TestExpectSSE_Failures(t *testing.T) {
+	s := SSEServer(
+		"data: connected\n\n",
+		"data: {\"Name\": \"Joe\"}\n\n",
+	)
+	defer s.Close()
+
+	t.Run("wrong data", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				Expect().SSE().Next().Data().Equal("disconnected"),
+			),
+			PtrStr("Not equal"), nil, nil, nil, nil, nil, nil,
+		)
+	})
+
+	t.Run("wrong json", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				Expect().SSE().Next(),
+				Expect().SSE().Next().Data().JSON().Equal("Name", "Alice"),
+			),
+			PtrStr("Not equal"), nil, nil, nil, nil, nil, nil,
+		)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				Expect().SSE().Timeout(100*time.Millisecond),
+				Expect().SSE().Next(),
+				Expect().SSE().Next(),
+				Expect().SSE().Next(),
+			),
+			PtrStr("no event received within 100ms"),
+		)
+	})
+
+	t.Run("max events", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				Expect().SSE().MaxEvents(1),
+				Expect().SSE().Next(),
+				Expect().SSE().Next(),
+			),
+			PtrStr("maximum of 1 events reached"),
+		)
+	})
+
+	t.Run("without chain", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				Expect().SSE(),
+			),
+			PtrStr("unable to run Expect().SSE() without a chain. Please use Expect().SSE().Something"),
+		)
+	})
+}
+
+func TestExpectSSE_StreamEnded(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprint(writer, "data: bye\n\n")
+	}))
+	defer s.Close()
+
+	ExpectError(t,
+		Do(
+			Get(s.URL),
+			Expect().SSE().Next().Data().Equal("bye"),
+			Expect().SSE().Next(),
+		),
+		PtrStr("event stream ended"),
+	)
+}
+
+func TestExpectSSE_Final(t *testing.T) {
+	ExpectError(t,
+		Do(Expect("Hello World").SSE().Next().Data().JSON().Equal("", nil)),
+		PtrStr("only usable with Expect() not with Expect(value)"),
+	)
+}
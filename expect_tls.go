@@ -0,0 +1,256 @@
+package hit
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// IExpectTLS provides assertions on the tls connection the response was received on, omit the chain to expect that
+// the response was received over tls.
+type IExpectTLS interface {
+	IStep
+	// Version expects the negotiated tls version to be the specified version.
+	//
+	// Usage:
+	//     Expect().TLS().Version(tls.VersionTLS13)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().TLS().Version(tls.VersionTLS13),
+	//     )
+	Version(version uint16) IStep
+
+	// PeerCertificate provides assertions on the leaf certificate of the server.
+	//
+	// Usage:
+	//     Expect().TLS().PeerCertificate().CommonName("example.com")
+	//     Expect().TLS().PeerCertificate().ExpiresAfter(30 * 24 * time.Hour)
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().TLS().PeerCertificate().ExpiresAfter(30 * 24 * time.Hour),
+	//     )
+	PeerCertificate() IExpectCertificate
+}
+
+// IExpectCertificate provides assertions on a x509 certificate.
+type IExpectCertificate interface {
+	IStep
+	// CommonName expects the subject common name of the certificate to be the specified name.
+	//
+	// Usage:
+	//     Expect().TLS().PeerCertificate().CommonName("example.com")
+	CommonName(name string) IStep
+
+	// DNSName expects the certificate to be valid for the specified host name.
+	//
+	// Usage:
+	//     Expect().TLS().PeerCertificate().DNSName("www.example.com")
+	DNSName(name string) IStep
+
+	// ExpiresAfter expects the certificate to be valid for at least the specified duration.
+	//
+	// Usage:
+	//     Expect().TLS().PeerCertificate().ExpiresAfter(30 * 24 * time.Hour)
+	ExpiresAfter(d time.Duration) IStep
+}
+
+func tlsVersionName(version uint16) string {
+	switch version {
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	}
+	return fmt.Sprintf("0x%04x", version)
+}
+
+// responseTLS returns the tls connection state of the response, it fails if the response was not received over tls
+func responseTLS(hit Hit) *tls.ConnectionState {
+	state := hit.Response().TLS
+	if state == nil {
+		minitest.Errorf("response was not received over a tls connection")
+	}
+	return state
+}
+
+type expectTLS struct {
+	expect    IExpect
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newExpectTLS(expect IExpect, cleanPath clearPath) IExpectTLS {
+	return &expectTLS{
+		expect:    expect,
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+}
+
+func (t *expectTLS) exec(hit Hit) error {
+	// expect that the response was received over tls
+	return (&hitStep{
+		Trace:     t.trace,
+		When:      ExpectStep,
+		ClearPath: t.cleanPath,
+		Exec: func(hit Hit) error {
+			responseTLS(hit)
+			return nil
+		},
+	}).exec(hit)
+}
+
+func (*expectTLS) when() StepTime {
+	return ExpectStep
+}
+
+func (t *expectTLS) clearPath() clearPath {
+	return t.cleanPath
+}
+
+func (t *expectTLS) Version(version uint16) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: t.clearPath().Push("Version", []interface{}{version}),
+		Exec: func(hit Hit) error {
+			if actual := responseTLS(hit).Version; actual != version {
+				minitest.Errorf("expected tls version to be %s, but was %s", tlsVersionName(version), tlsVersionName(actual))
+			}
+			return nil
+		},
+	}
+}
+
+func (t *expectTLS) PeerCertificate() IExpectCertificate {
+	return &expectCertificate{
+		cleanPath: t.clearPath().Push("PeerCertificate", nil),
+		trace:     ett.Prepare(),
+	}
+}
+
+type expectCertificate struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func (c *expectCertificate) exec(hit Hit) error {
+	return c.trace.Format(hit.Description(), "unable to run Expect().TLS().PeerCertificate() without a chain. Please use Expect().TLS().PeerCertificate().Something")
+}
+
+func (*expectCertificate) when() StepTime {
+	return ExpectStep
+}
+
+func (c *expectCertificate) clearPath() clearPath {
+	return c.cleanPath
+}
+
+// step returns a step that runs fn with the leaf certificate of the server
+func (c *expectCertificate) step(name string, args []interface{}, fn func(cert *x509.Certificate)) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: c.clearPath().Push(name, args),
+		Exec: func(hit Hit) error {
+			state := responseTLS(hit)
+			if len(state.PeerCertificates) == 0 {
+				minitest.Errorf("server did not present a certificate")
+			}
+			fn(state.PeerCertificates[0])
+			return nil
+		},
+	}
+}
+
+func (c *expectCertificate) CommonName(name string) IStep {
+	return c.step("CommonName", []interface{}{name}, func(cert *x509.Certificate) {
+		if cert.Subject.CommonName != name {
+			minitest.Errorf("expected certificate common name to be %s, but was %s", minitest.PrintValue(name), minitest.PrintValue(cert.Subject.CommonName))
+		}
+	})
+}
+
+func (c *expectCertificate) DNSName(name string) IStep {
+	return c.step("DNSName", []interface{}{name}, func(cert *x509.Certificate) {
+		if err := cert.VerifyHostname(name); err != nil {
+			minitest.Errorf("expected certificate to be valid for %s, but it is valid for %v", name, cert.DNSNames)
+		}
+	})
+}
+
+func (c *expectCertificate) ExpiresAfter(d time.Duration) IStep {
+	return c.step("ExpiresAfter", []interface{}{d}, func(cert *x509.Certificate) {
+		if deadline := time.Now().Add(d); cert.NotAfter.Before(deadline) {
+			minitest.Errorf("expected certificate to be valid until at least %s, but it expires %s", deadline.UTC().Format(time.RFC3339), cert.NotAfter.UTC().Format(time.RFC3339))
+		}
+	})
+}
+
+type finalExpectTLS struct {
+	IStep
+	message string
+}
+
+func (t *finalExpectTLS) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(t.message)
+		},
+	}
+}
+
+func (t *finalExpectTLS) Version(uint16) IStep {
+	return t.fail()
+}
+
+func (t *finalExpectTLS) PeerCertificate() IExpectCertificate {
+	return &finalExpectCertificate{
+		t.fail(),
+		t.message,
+	}
+}
+
+type finalExpectCertificate struct {
+	IStep
+	message string
+}
+
+func (c *finalExpectCertificate) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(c.message)
+		},
+	}
+}
+
+func (c *finalExpectCertificate) CommonName(string) IStep {
+	return c.fail()
+}
+
+func (c *finalExpectCertificate) DNSName(string) IStep {
+	return c.fail()
+}
+
+func (c *finalExpectCertificate) ExpiresAfter(time.Duration) IStep {
+	return c.fail()
+}
@@ -0,0 +1,229 @@
+package hit_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certFile string
+	keyFile  string
+}
+
+// newTestCert creates a certificate that is signed by the parent (or self signed if parent is nil)
+// and writes it to dir
+func newTestCert(t *testing.T, dir, name string, template *x509.Certificate, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.Subject = pkix.Name{CommonName: name}
+	if template.NotBefore.IsZero() {
+		template.NotBefore = time.Now().Add(-time.Hour)
+	}
+	if template.NotAfter.IsZero() {
+		template.NotAfter = time.Now().Add(90 * 24 * time.Hour)
+	}
+
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	c := &testCert{
+		cert:     cert,
+		key:      key,
+		certFile: filepath.Join(dir, name+".crt"),
+		keyFile:  filepath.Join(dir, name+".key"),
+	}
+	require.NoError(t, ioutil.WriteFile(c.certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(c.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return c
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{
+		Certificate: [][]byte{c.cert.Raw},
+		PrivateKey:  c.key,
+	}
+}
+
+func TestTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hit-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCert(t, dir, "ca", &x509.Certificate{
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+	server := newTestCert(t, dir, "server", &x509.Certificate{
+		DNSNames:    []string{"example.com"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	client := newTestCert(t, dir, "client", &x509.Certificate{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	s := httptest.NewUnstartedServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if len(request.TLS.PeerCertificates) == 0 {
+			writer.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = writer.Write([]byte("Hello " + request.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	s.TLS = &tls.Config{
+		Certificates: []tls.Certificate{server.tlsCertificate()},
+		ClientCAs:    pool,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		MinVersion:   tls.VersionTLS12,
+		MaxVersion:   tls.VersionTLS12,
+	}
+	// do not log the handshake errors of the untrusted requests
+	s.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	s.StartTLS()
+	defer s.Close()
+
+	t.Run("untrusted", func(t *testing.T) {
+		err := Do(Get(s.URL))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "certificate")
+	})
+
+	t.Run("RootCAs", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			RootCAs(ca.certFile),
+			Expect().Status(http.StatusUnauthorized),
+			Expect().TLS(),
+			Expect().TLS().Version(tls.VersionTLS12),
+			Expect().TLS().PeerCertificate().CommonName("server"),
+			Expect().TLS().PeerCertificate().DNSName("example.com"),
+			Expect().TLS().PeerCertificate().ExpiresAfter(30*24*time.Hour),
+		)
+	})
+
+	t.Run("TLSClientCert", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			RootCAs(ca.certFile),
+			TLSClientCert(client.certFile, client.keyFile),
+			Expect().Status(http.StatusOK),
+			Expect().Body("Hello client"),
+		)
+	})
+
+	t.Run("InsecureSkipVerify", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			InsecureSkipVerify(),
+			Expect().Status(http.StatusUnauthorized),
+		)
+	})
+
+	t.Run("ServerName", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			RootCAs(ca.certFile),
+			ServerName("example.com"),
+			Expect().Status(http.StatusUnauthorized),
+		)
+
+		err := Do(
+			Get(s.URL),
+			RootCAs(ca.certFile),
+			ServerName("example.org"),
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "example.org")
+	})
+
+	t.Run("failing assertions", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				InsecureSkipVerify(),
+				Expect().TLS().Version(tls.VersionTLS13),
+			),
+			PtrStr("expected tls version to be TLS 1.3, but was TLS 1.2"),
+		)
+
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				InsecureSkipVerify(),
+				Expect().TLS().PeerCertificate().CommonName("example.com"),
+			),
+			PtrStr(`expected certificate common name to be "example.com", but was "server"`),
+		)
+
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				InsecureSkipVerify(),
+				Expect().TLS().PeerCertificate().ExpiresAfter(365*24*time.Hour),
+			),
+			nil,
+		)
+
+		plain := EchoServer()
+		defer plain.Close()
+		ExpectError(t,
+			Do(
+				Get(plain.URL),
+				Expect().TLS(),
+			),
+			PtrStr("response was not received over a tls connection"),
+		)
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		err := Do(
+			Get(s.URL),
+			RootCAs(client.keyFile),
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no certificates found in "+client.keyFile)
+	})
+
+	t.Run("default client is not modified", func(t *testing.T) {
+		require.Nil(t, http.DefaultClient.Transport)
+		if cfg := http.DefaultTransport.(*http.Transport).TLSClientConfig; cfg != nil {
+			require.Nil(t, cfg.RootCAs)
+			require.Empty(t, cfg.Certificates)
+			require.False(t, cfg.InsecureSkipVerify)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package hit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+)
+
+func trailerServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+		_, _ = writer.Write([]byte("Hello World"))
+		writer.Header().Set("Grpc-Status", "0")
+		writer.Header().Set("Grpc-Message", "OK")
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestExpectTrailer(t *testing.T) {
+	s := trailerServer()
+	defer s.Close()
+
+	t.Run("specific trailer", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			Expect().Trailer("grpc-status").Equal(0),
+			Expect().Trailer("Grpc-Message").Equal("OK"),
+			Expect().Trailer("Grpc-Message").NotEqual("ERROR"),
+			Expect().Trailer("X-Unknown").Empty(),
+			Expect().Body("Hello World"),
+		)
+	})
+
+	t.Run("trailers", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			Expect().Trailer().Contains("Grpc-Status"),
+			Expect().Trailer().NotContains("X-Unknown"),
+			Expect().Trailer().Len(2),
+			// the trailers are not part of the header
+			Expect().Header().NotContains("Grpc-Status"),
+		)
+	})
+
+	t.Run("after reading the body", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			Expect().Body("Hello World"),
+			Expect().Trailer("Grpc-Status").Equal(0),
+		)
+	})
+
+	t.Run("failing", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				Expect().Trailer("Grpc-Status").Equal(2),
+			),
+			PtrStr("Not equal"),
+			PtrStr("expected: 2"),
+			PtrStr("actual: 0"),
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+	})
+}
+
+func TestExpectProto(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Get(s.URL),
+		Expect().Proto().Equal("HTTP/1.1"),
+		Expect().Proto().NotEqual("HTTP/2.0"),
+	)
+
+	ExpectError(t,
+		Do(
+			Get(s.URL),
+			Expect().Proto().Equal("HTTP/2.0"),
+		),
+		PtrStr(`expected protocol to be "HTTP/2.0", but was "HTTP/1.1"`),
+	)
+
+	ExpectError(t,
+		Do(
+			Get(s.URL),
+			Expect().Proto().NotEqual("HTTP/1.1"),
+		),
+		PtrStr(`expected protocol not to be "HTTP/1.1"`),
+	)
+
+	ExpectError(t,
+		Do(
+			Get(s.URL),
+			Expect().Proto(),
+		),
+		PtrStr("unable to run Expect().Proto() without a chain. Please use Expect().Proto().Something"),
+	)
+}
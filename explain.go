@@ -0,0 +1,139 @@
+package hit
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Eun/go-hit/errortrace"
+	"golang.org/x/xerrors"
+)
+
+// Explain resolves the CombineSteps() and Clear() steps without sending a request and returns the final steps ordered
+// by the StepTime they run in, each with its CallString() and the location it was created at.
+// Use it to see which steps of Defaults(), suites and templates are left for a request.
+//
+// Steps that are added during later phases (e.g. by If() or Custom() steps) are not resolved.
+//
+// Example:
+//     explanation, err := Explain(
+//         Post("https://example.com"),
+//         CombineSteps(
+//             Send().Body("Hello World"),
+//             Expect().Body().Equal("Hello World"),
+//         ),
+//         Clear().Expect(),
+//     )
+//     if err != nil {
+//         panic(err)
+//     }
+//     fmt.Println(explanation)
+func Explain(steps ...IStep) (string, error) {
+	hit := &defaultInstance{
+		client:  http.DefaultClient,
+		stdout:  os.Stdout,
+		steps:   withDefaults(steps),
+		state:   CombineStep,
+		explain: true,
+	}
+	if err := hit.runSteps(CombineStep); err != nil {
+		return "", err
+	}
+	hit.state = CleanStep
+	if err := hit.runSteps(CleanStep); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	for when := BeforeSendStep; when <= AfterExpectStep; when++ {
+		phaseSteps := hit.collectSteps(when)
+		if len(phaseSteps) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "%s:\n", when.String())
+		for _, step := range phaseSteps {
+			name := "<unknown>"
+			if p := step.clearPath(); p != nil {
+				name = p.CallString()
+			}
+			location := "<unknown>"
+			if call, ok := stepCall(step); ok {
+				location = fmt.Sprintf("%s:%d", call.File, call.Line)
+			}
+			fmt.Fprintf(w, "  %s\t%s\n", name, location)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// stepCall returns the location the step was created at
+func stepCall(step IStep) (errortrace.Call, bool) {
+	switch s := step.(type) {
+	case *hitStep:
+		if s.Trace == nil {
+			return errortrace.Call{}, false
+		}
+		return s.Trace.Call()
+	case *debug:
+		return s.trace.Call()
+	case *skipStep:
+		return s.trace.Call()
+	}
+	// steps like labeledStep or the final steps embed the actual step
+	v := reflect.ValueOf(step)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return errortrace.Call{}, false
+	}
+	if f := v.Elem().FieldByName("IStep"); f.IsValid() && !f.IsNil() {
+		if embedded, ok := f.Interface().(IStep); ok {
+			return stepCall(embedded)
+		}
+	}
+	return errortrace.Call{}, false
+}
+
+// DryRun runs all steps up to the sending of the request (including the OnBeforeSend() hooks) and calls fn with the
+// complete request (url, headers and body) instead of sending it, the Expect() steps do not run.
+//
+// Example:
+//     MustDo(
+//         Post("https://example.com"),
+//         Send().Header("Content-Type", "application/json"),
+//         Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+//         DryRun(func(request *HTTPRequest) {
+//             fmt.Println(request.URL.String(), request.Body().String())
+//         }),
+//     )
+func DryRun(fn func(request *HTTPRequest)) IStep {
+	callback := &hitStep{
+		Trace:     ett.Prepare(),
+		When:      AfterSendStep,
+		ClearPath: nil, // not clearable
+		Exec: func(hit Hit) error {
+			if fn != nil {
+				fn(hit.Request())
+			}
+			return nil
+		},
+	}
+	return &hitStep{
+		Trace:     callback.Trace,
+		When:      BeforeSendStep,
+		ClearPath: newClearPath("DryRun", nil),
+		Exec: func(hit Hit) error {
+			instance, ok := hit.(*defaultInstance)
+			if !ok {
+				return xerrors.New("DryRun() can only be used with the default hit instance")
+			}
+			instance.dryRun = callback
+			return nil
+		},
+	}
+}
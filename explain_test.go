@@ -0,0 +1,123 @@
+package hit_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	t.Run("resolves combine steps and clears", func(t *testing.T) {
+		explanation, err := Explain(
+			Post("https://example.com"),
+			CombineSteps(
+				Send().Body("Hello World"),
+				Expect().Body().Equal("Hello World"),
+			),
+			Clear().Expect(),
+			Expect().Status().Equal(http.StatusOK),
+		)
+		require.NoError(t, err)
+		require.Regexp(t, regexp.MustCompile(`^BeforeSendStep:
+  Post\("https://example.com"\)\s+\S+explain_test.go:\d+
+SendStep:
+  Send\(\).Body\("Hello World"\)\s+\S+explain_test.go:\d+
+ExpectStep:
+  Expect\(\).Status\(\).Equal\(200\)\s+\S+explain_test.go:\d+
+$`), explanation)
+	})
+
+	t.Run("does not send the request", func(t *testing.T) {
+		ranCustomFunc := false
+		explanation, err := Explain(
+			Get("http://%s", "invalid.invalid"),
+			Custom(ExpectStep, func(hit Hit) {
+				ranCustomFunc = true
+			}),
+			OnBeforeSend(func(hit Hit) {
+				ranCustomFunc = true
+			}),
+		)
+		require.NoError(t, err)
+		require.False(t, ranCustomFunc)
+		require.Contains(t, explanation, "ExpectStep:\n  Custom(ExpectStep, ")
+	})
+
+	t.Run("groups", func(t *testing.T) {
+		explanation, err := Explain(
+			Name("checks", Expect().Status().Equal(http.StatusOK)),
+			Debug(),
+		)
+		require.NoError(t, err)
+		require.Regexp(t, regexp.MustCompile(`^BeforeExpectStep:
+  Debug\(\)\s+\S+explain_test.go:\d+
+ExpectStep:
+  Expect\(\).Status\(\).Equal\(200\)\s+\S+explain_test.go:\d+
+$`), explanation)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Explain(
+			Clear().Expect(),
+		)
+		ExpectError(t, err, PtrStr("unable to find a step with Expect()"))
+	})
+}
+
+func TestDryRun(t *testing.T) {
+	var request *HTTPRequest
+	Test(t,
+		Post("http://%s/users", "invalid.invalid"),
+		Send().Header("X-Foo", "Bar"),
+		Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+		OnBeforeSend(func(hit Hit) {
+			hit.Request().Header.Set("X-Request-Id", "1")
+		}),
+		DryRun(func(r *HTTPRequest) {
+			request = r
+		}),
+		Expect().Status().Equal(http.StatusNotFound),
+	)
+	require.NotNil(t, request)
+	require.Equal(t, http.MethodPost, request.Method)
+	require.Equal(t, "http://invalid.invalid/users", request.URL.String())
+	require.Equal(t, "Bar", request.Header.Get("X-Foo"))
+	require.Equal(t, "1", request.Header.Get("X-Request-Id"))
+	require.Equal(t, `{"Name":"Joe"}`, request.Body().String())
+
+	t.Run("panic", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Post("http://%s", "invalid.invalid"),
+				DryRun(func(r *HTTPRequest) {
+					panic("failed")
+				}),
+			),
+			PtrStr("failed"),
+		)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		s := EchoServer()
+		defer s.Close()
+
+		var buf bytes.Buffer
+		Test(t,
+			Post(s.URL),
+			Send().Body("Hello World"),
+			DryRun(nil),
+			Clear().Method(),
+			Replace(DryRun(nil)),
+			Post(s.URL),
+			Custom(ExpectStep, func(hit Hit) {
+				fmt.Fprint(&buf, hit.Response().Body().String())
+			}),
+		)
+		require.Equal(t, "Hello World", buf.String())
+	})
+}
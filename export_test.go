@@ -0,0 +1,5 @@
+package hit
+
+// SnapshotCodecs returns a function that restores the registered codecs, use it to undo RegisterCodec() in tests.
+//nolint:gochecknoglobals
+var SnapshotCodecs = snapshotCodecs
@@ -10,6 +10,7 @@ import (
 	"go/token"
 	"io/ioutil"
 	"log"
+	"regexp"
 	"strings"
 
 	"sort"
@@ -229,17 +230,13 @@ func expandType(typ string, pkg string, types []string) string {
 		typ = typ[3:]
 		ellipsis = "..."
 	}
-	for _, t := range types {
-		if t == typ {
-			return fmt.Sprintf("%s%s.%s", ellipsis, pkg, typ)
-		}
-	}
-
-	if typ == "func(Hit)" {
-		typ = "func(hit.Hit)"
+	// qualify the types of the package, also inside of func, map and slice types e.g. func(hit Hit) bool
+	typeNames := make([]string, len(types))
+	for i, t := range types {
+		typeNames[i] = regexp.QuoteMeta(t)
 	}
-
-	return ellipsis + typ
+	re := regexp.MustCompile(`(^|[^.\w])(` + strings.Join(typeNames, "|") + `)\b`)
+	return ellipsis + re.ReplaceAllString(typ, "${1}"+pkg+".${2}")
 }
 
 func getDecls(pkgs map[string]*ast.Package, pkgName string) (decl []ast.Decl) {
@@ -247,14 +244,16 @@ func getDecls(pkgs map[string]*ast.Package, pkgName string) (decl []ast.Decl) {
 		if pkg.Name != pkgName {
 			continue
 		}
-		for _, f := range pkg.Files {
-			decl = append(decl, f.Decls...)
+		// use the order of the files and the declarations, so the generated file is stable
+		fileNames := make([]string, 0, len(pkg.Files))
+		for fileName := range pkg.Files {
+			fileNames = append(fileNames, fileName)
+		}
+		sort.Strings(fileNames)
+		for _, fileName := range fileNames {
+			decl = append(decl, pkg.Files[fileName].Decls...)
 		}
 	}
 
-	sort.Slice(decl, func(i, j int) bool {
-		return strings.Compare(fmt.Sprintf("%s", decl[i]), fmt.Sprintf("%s", decl[j])) < 0
-	})
-
 	return decl
 }
@@ -1,13 +1,15 @@
 module github.com/Eun/go-hit
 
-go 1.12
+go 1.13
 
 require (
 	github.com/Eun/go-convert v0.0.0-20200210091419-ec93d4c12868
 	github.com/Eun/go-doppelgangerreader v0.0.0-20190911075941-30f1527f16b2
+	github.com/andybalholm/brotli v1.0.6
 	github.com/google/go-cmp v0.4.0
 	github.com/gookit/color v1.2.3
 	github.com/k0kubun/pp v3.0.1+incompatible
+	github.com/klauspost/compress v1.11.13
 	github.com/lunixbochs/vtclean v1.0.0
 	github.com/mattn/go-colorable v0.1.6 // indirect
 	github.com/mohae/deepcopy v0.0.0-20170929034955-c48cc78d4826
@@ -15,4 +17,5 @@ require (
 	github.com/tidwall/pretty v1.0.1
 	golang.org/x/tools v0.0.0-20200318150045-ba25ddc85566
 	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543
+	gopkg.in/yaml.v2 v2.2.2
 )
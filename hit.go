@@ -84,6 +84,22 @@ type Hit interface {
 	// SetDescription sets a custom description for this test.
 	// The description will be printed in an error case
 	SetDescription(string)
+
+	// Var gets the value of the variable with the specified name.
+	// Variables are set by the named capture groups of Matches() steps or with SetVar().
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Expect().Header("Location").Matches(`/users/(?P<id>\d+)`),
+	//         Expect().Custom(func(hit Hit) {
+	//             fmt.Println(hit.Var("id"))
+	//         }),
+	//     )
+	Var(name string) string
+
+	// SetVar sets the variable with the specified name to the value
+	SetVar(name, value string)
 }
 
 type defaultInstance struct {
@@ -96,6 +112,35 @@ type defaultInstance struct {
 	stdout      io.Writer
 	baseURL     string
 	description string
+	vars        map[string]string
+
+	// softAssertions is set by SoftAssertions(), failing ExpectSteps will be collected in softErrors
+	softAssertions bool
+	softErrors     []error
+
+	// noFollowRedirects and maxRedirects are set by FollowRedirects() and MaxRedirects()
+	noFollowRedirects bool
+	maxRedirects      *int
+
+	// transport is set by steps that configure the transport, e.g. TLSClientCert()
+	transport *transportConfig
+
+	// hooks are registered by OnBeforeSend(), OnResponse(), OnFailure() and OnStep()
+	hooks           []*hookStep
+	beforeSendHooks []*hookStep
+	responseHooks   []*hookStep
+	failureHooks    []*hookStep
+	stepHooks       []*hookStep
+
+	// insertedSteps contains the steps that were inserted by a step (e.g. CombineSteps()), so they can be removed
+	// together with the step
+	insertedSteps map[IStep][]IStep
+
+	// explain is set by Explain(), hooks are not registered
+	explain bool
+
+	// dryRun is set by DryRun(), it runs instead of sending the request
+	dryRun *hitStep
 }
 
 func (hit *defaultInstance) Request() *HTTPRequest {
@@ -164,8 +209,16 @@ nextStep:
 		}
 
 		hit.currentStep = stepsToRun[i]
-		if err := stepsToRun[i].exec(hit); err != nil {
-			return err
+		err := stepsToRun[i].exec(hit)
+		if hookErr := hit.runStepHooks(stepsToRun[i], state, err); hookErr != nil {
+			return hookErr
+		}
+		if err != nil {
+			if !hit.softAssertions || state != ExpectStep || isSkipped(err) {
+				return err
+			}
+			// remember the error and continue with the next expectation
+			hit.softErrors = append(hit.softErrors, err)
 		}
 		executedSteps = append(executedSteps, stepsToRun[i])
 
@@ -214,16 +267,32 @@ func (hit *defaultInstance) InsertSteps(steps ...IStep) {
 			continue
 		}
 		hit.steps = append(hit.steps[:i+1], append(steps, hit.steps[i+1:]...)...)
+		if hit.insertedSteps == nil {
+			hit.insertedSteps = make(map[IStep][]IStep)
+		}
+		hit.insertedSteps[step] = append(hit.insertedSteps[step], steps...)
 		return
 	}
 }
 
+// withInsertedSteps returns the specified steps and all steps that were inserted by them
+func (hit *defaultInstance) withInsertedSteps(steps []IStep) []IStep {
+	result := append([]IStep(nil), steps...)
+	for i := 0; i < len(result); i++ {
+		result = append(result, hit.insertedSteps[result[i]]...)
+	}
+	return result
+}
+
 //nolint:gomnd
 func (hit *defaultInstance) RemoveSteps(steps ...IStep) {
 removeStep:
 	for j := len(steps) - 1; j >= 0; j-- {
 		for i := len(hit.steps) - 1; i >= 0; i-- {
 			if hit.steps[i] == steps[j] {
+				if hook, ok := steps[j].(*hookStep); ok {
+					hit.removeHook(hook)
+				}
 				// remove the step from steps and hit.steps
 				steps = append(steps[:j], steps[j+1:]...)
 				hit.steps = append(hit.steps[:i], hit.steps[i+1:]...)
@@ -258,3 +327,14 @@ func (hit *defaultInstance) Description() string {
 func (hit *defaultInstance) SetDescription(description string) {
 	hit.description = description
 }
+
+func (hit *defaultInstance) Var(name string) string {
+	return hit.vars[name]
+}
+
+func (hit *defaultInstance) SetVar(name, value string) {
+	if hit.vars == nil {
+		hit.vars = make(map[string]string)
+	}
+	hit.vars[name] = value
+}
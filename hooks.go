@@ -0,0 +1,199 @@
+package hit
+
+import (
+	"github.com/Eun/go-hit/errortrace"
+)
+
+// hookStep registers a hook that runs at a specific point of the request lifecycle, hooks are registered before any
+// step runs so they can be used with Defaults() and NewSuite() as well
+type hookStep struct {
+	trace      *errortrace.ErrorTrace
+	name       string
+	beforeSend Callback
+	response   Callback
+	failure    func(hit Hit, err error)
+	step       func(hit Hit, step IStep, when StepTime, err error)
+}
+
+func (*hookStep) when() StepTime {
+	return CombineStep
+}
+
+func (h *hookStep) clearPath() clearPath {
+	var fn interface{}
+	switch {
+	case h.beforeSend != nil:
+		fn = h.beforeSend
+	case h.response != nil:
+		fn = h.response
+	case h.failure != nil:
+		fn = h.failure
+	case h.step != nil:
+		fn = h.step
+	}
+	return newClearPath(h.name, []interface{}{fn})
+}
+
+func (h *hookStep) exec(hit Hit) error {
+	// hooks are registered by Do(), this only registers hooks that were added later e.g. with CombineSteps()
+	instance, ok := hit.(*defaultInstance)
+	if !ok {
+		return h.trace.Format(hit.Description(), h.name+"() can only be used with the default hit instance")
+	}
+	if instance.explain {
+		// Explain() does not send a request, the hooks would see an incomplete instance
+		return nil
+	}
+	instance.addHook(h)
+	return nil
+}
+
+// addHook registers the hook, a hook is only registered once
+func (hit *defaultInstance) addHook(h *hookStep) {
+	for _, hook := range hit.hooks {
+		if hook == h {
+			return
+		}
+	}
+	hit.hooks = append(hit.hooks, h)
+	switch {
+	case h.beforeSend != nil:
+		hit.beforeSendHooks = append(hit.beforeSendHooks, h)
+	case h.response != nil:
+		hit.responseHooks = append(hit.responseHooks, h)
+	case h.failure != nil:
+		hit.failureHooks = append(hit.failureHooks, h)
+	case h.step != nil:
+		hit.stepHooks = append(hit.stepHooks, h)
+	}
+}
+
+// removeHook unregisters the hook, it is called if the hook step was removed with Clear()
+func (hit *defaultInstance) removeHook(h *hookStep) {
+	without := func(hooks []*hookStep) []*hookStep {
+		result := hooks[:0]
+		for _, hook := range hooks {
+			if hook != h {
+				result = append(result, hook)
+			}
+		}
+		return result
+	}
+	hit.hooks = without(hit.hooks)
+	hit.beforeSendHooks = without(hit.beforeSendHooks)
+	hit.responseHooks = without(hit.responseHooks)
+	hit.failureHooks = without(hit.failureHooks)
+	hit.stepHooks = without(hit.stepHooks)
+}
+
+// runHooks runs the before send or response hooks
+func (hit *defaultInstance) runHooks(hooks []*hookStep) error {
+	for _, h := range hooks {
+		fn := h.beforeSend
+		if fn == nil {
+			fn = h.response
+		}
+		if err := h.run(hit, func() { fn(hit) }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runStepHooks runs the step hooks for the step that was executed during the state with the error of the step
+func (hit *defaultInstance) runStepHooks(step IStep, state StepTime, stepErr error) error {
+	for _, h := range hit.stepHooks {
+		if err := h.run(hit, func() { h.step(hit, step, state, stepErr) }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runFailureHooks runs the failure hooks, they only observe the error so failing failure hooks do not change the
+// error of Do()
+func (hit *defaultInstance) runFailureHooks(failure error) {
+	for _, h := range hit.failureHooks {
+		_ = h.run(hit, func() { h.failure(hit, failure) })
+	}
+}
+
+// run runs fn and turns panics (e.g. failed assertions) into errors that point to the registration of the hook
+func (h *hookStep) run(hit Hit, fn func()) error {
+	return (&hitStep{
+		Trace: h.trace,
+		Exec: func(Hit) error {
+			fn()
+			return nil
+		},
+	}).exec(hit)
+}
+
+// OnBeforeSend registers a hook that runs right before the request is sent, after all send steps ran.
+// Use it with Defaults() or NewSuite() to modify every request.
+//
+// Example:
+//     Defaults(
+//         OnBeforeSend(func(hit Hit) {
+//             hit.Request().Header.Set("X-Request-Id", uuid.New().String())
+//         }),
+//     )
+func OnBeforeSend(fn Callback) IStep {
+	return &hookStep{
+		trace:      ett.Prepare(),
+		name:       "OnBeforeSend",
+		beforeSend: fn,
+	}
+}
+
+// OnResponse registers a hook that runs right after the response was received, before the expect steps run.
+//
+// Example:
+//     Defaults(
+//         OnResponse(func(hit Hit) {
+//             log.Printf("%s %s: %d", hit.Request().Method, hit.Request().URL, hit.Response().StatusCode)
+//         }),
+//     )
+func OnResponse(fn Callback) IStep {
+	return &hookStep{
+		trace:    ett.Prepare(),
+		name:     "OnResponse",
+		response: fn,
+	}
+}
+
+// OnFailure registers a hook that runs if the request failed, err is the error that Do() returns.
+// The response (if there is any) is still readable.
+//
+// Example:
+//     Defaults(
+//         OnFailure(func(hit Hit, err error) {
+//             if hit.Response() != nil {
+//                 log.Printf("%s failed: %s", hit.Request().URL, hit.Response().Body().String())
+//             }
+//         }),
+//     )
+func OnFailure(fn func(hit Hit, err error)) IStep {
+	return &hookStep{
+		trace:   ett.Prepare(),
+		name:    "OnFailure",
+		failure: fn,
+	}
+}
+
+// OnStep registers a hook that runs after every step, when is the phase the step ran in and err is the error of the
+// step.
+//
+// Example:
+//     Defaults(
+//         OnStep(func(hit Hit, step IStep, when StepTime, err error) {
+//             stepCounter.WithLabelValues(when.String()).Inc()
+//         }),
+//     )
+func OnStep(fn func(hit Hit, step IStep, when StepTime, err error)) IStep {
+	return &hookStep{
+		trace: ett.Prepare(),
+		name:  "OnStep",
+		step:  fn,
+	}
+}
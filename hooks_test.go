@@ -0,0 +1,198 @@
+package hit_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHooks(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("order", func(t *testing.T) {
+		var calls []string
+		Test(t,
+			Post(s.URL),
+			Send().Body("Hello"),
+			OnBeforeSend(func(hit Hit) {
+				require.Nil(t, hit.Response())
+				calls = append(calls, "before send")
+			}),
+			OnResponse(func(hit Hit) {
+				require.NotNil(t, hit.Response())
+				calls = append(calls, "response")
+			}),
+			OnFailure(func(hit Hit, err error) {
+				calls = append(calls, "failure")
+			}),
+			Expect().Custom(func(hit Hit) {
+				calls = append(calls, "expect")
+			}),
+		)
+		require.Equal(t, []string{"before send", "response", "expect"}, calls)
+	})
+
+	t.Run("OnBeforeSend modifies the request", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			OnBeforeSend(func(hit Hit) {
+				hit.Request().Header.Set("X-Request-Id", "123")
+			}),
+			Expect().Header("X-Request-Id").Equal("123"),
+		)
+	})
+
+	t.Run("OnFailure", func(t *testing.T) {
+		var failure error
+		var body string
+		err := Do(
+			Post(s.URL),
+			Send().Body("Hello World"),
+			Expect().Body("Hello Earth"),
+			OnFailure(func(hit Hit, err error) {
+				failure = err
+				// the response is still readable
+				body = hit.Response().Body().String()
+			}),
+		)
+		require.Error(t, err)
+		require.Equal(t, err, failure)
+		require.Equal(t, "Hello World", body)
+	})
+
+	t.Run("OnFailure without request", func(t *testing.T) {
+		var failure error
+		err := Do(
+			Get("invalid://"),
+			OnFailure(func(hit Hit, err error) {
+				failure = err
+			}),
+		)
+		require.Error(t, err)
+		require.Equal(t, err, failure)
+	})
+
+	t.Run("OnStep", func(t *testing.T) {
+		var steps []string
+		expectErr := errors.New("custom error")
+		err := Do(
+			Get(s.URL),
+			Expect().Status(http.StatusOK),
+			Custom(ExpectStep, func(hit Hit) {
+				panic(expectErr)
+			}),
+			OnStep(func(hit Hit, step IStep, when StepTime, err error) {
+				s := when.String()
+				if err != nil {
+					s += " failed"
+				}
+				steps = append(steps, s)
+			}),
+		)
+		require.Error(t, err)
+		require.Equal(t, []string{
+			"CombineStep",
+			"BeforeSendStep",
+			"ExpectStep",
+			"ExpectStep failed",
+		}, steps)
+	})
+
+	t.Run("failing hook", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				OnResponse(func(hit Hit) {
+					panic("hook failed")
+				}),
+			),
+			PtrStr("hook failed"),
+		)
+	})
+
+	t.Run("CombineSteps", func(t *testing.T) {
+		var called bool
+		Test(t,
+			Get(s.URL),
+			CombineSteps(
+				OnResponse(func(hit Hit) {
+					called = true
+				}),
+			),
+		)
+		require.True(t, called)
+	})
+
+	t.Run("Suite and Defaults", func(t *testing.T) {
+		var calls []string
+		Defaults(
+			OnBeforeSend(func(hit Hit) {
+				calls = append(calls, "default "+hit.Request().URL.Path)
+			}),
+		)
+		defer Defaults()
+
+		suite := NewSuite(
+			BaseURL(s.URL),
+			OnResponse(func(hit Hit) {
+				calls = append(calls, "suite "+hit.Request().URL.Path)
+			}),
+		)
+		suite.Test(t, Get("/a"))
+		suite.Test(t, Get("/b"))
+		Test(t, Get(s.URL+"/c"))
+		require.Equal(t, "default /a,suite /a,default /b,suite /b,default /c", strings.Join(calls, ","))
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		var calls []string
+		beforeSend := func(hit Hit) {
+			calls = append(calls, "before send")
+		}
+		suite := NewSuite(
+			BaseURL(s.URL),
+			OnBeforeSend(beforeSend),
+			OnBeforeSend(func(hit Hit) {
+				calls = append(calls, "other before send")
+			}),
+			OnResponse(func(hit Hit) {
+				calls = append(calls, "response")
+			}),
+			OnFailure(func(hit Hit, err error) {
+				calls = append(calls, "failure")
+			}),
+			OnStep(func(hit Hit, step IStep, when StepTime, err error) {
+				if when == ExpectStep {
+					calls = append(calls, "step")
+				}
+			}),
+		)
+
+		suite.Test(t,
+			Get("/"),
+			Clear().OnBeforeSend(beforeSend),
+			Clear().OnResponse(),
+			Clear().OnStep(),
+		)
+		require.Equal(t, []string{"other before send"}, calls)
+
+		calls = nil
+		require.Error(t, suite.Do(
+			Get("/"),
+			Clear().OnBeforeSend(),
+			Clear().OnFailure(),
+			Expect().Status().Equal(http.StatusNotFound),
+		))
+		require.Equal(t, []string{"response", "step"}, calls)
+
+		// the hooks of the suite are still registered for the next request
+		calls = nil
+		suite.Test(t, Get("/"))
+		require.Equal(t, []string{"before send", "other before send", "response"}, calls)
+	})
+}
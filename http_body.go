@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"io/ioutil"
+	"net/http"
 
 	"bytes"
 
@@ -14,16 +15,23 @@ import (
 
 	"github.com/Eun/go-doppelgangerreader"
 	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
 )
 
 type HTTPBody struct {
 	hit     Hit
 	factory doppelgangerreader.DoppelgangerFactory
+	// source is the reader the factory mimics, it is used to stream the body without buffering it
+	source io.Reader
+	ndjson *HTTPNDJSON
+	// header returns the headers that describe the body (Content-Encoding and Content-Type)
+	header func() http.Header
 }
 
-func newHTTPBody(hit Hit, body io.Reader) *HTTPBody {
+func newHTTPBody(hit Hit, body io.Reader, header func() http.Header) *HTTPBody {
 	b := &HTTPBody{
-		hit: hit,
+		hit:    hit,
+		header: header,
 	}
 	if body != nil {
 		b.SetReader(body)
@@ -39,6 +47,7 @@ func (body *HTTPBody) SetReader(r io.Reader) {
 		body.factory = nil
 	}
 	body.factory = doppelgangerreader.NewFactory(r)
+	body.source = r
 }
 
 // SetString sets the body to the specified byte slice
@@ -131,17 +140,94 @@ func (body *HTTPBody) Set(data interface{}) {
 }
 
 // getters
-// Reader returns the body as an reader
+// Reader returns the body as an reader in its wire form, use String(), JSON(), ... to read the body decoded based
+// on the Content-Encoding and the charset of the Content-Type header.
 func (body *HTTPBody) Reader() io.ReadCloser {
+	return body.RawReader()
+}
+
+// decodedReader returns the body as an reader that is decoded based on the Content-Encoding and the charset of the
+// Content-Type header
+func (body *HTTPBody) decodedReader() (io.ReadCloser, error) {
+	r := body.RawReader()
+	if r == nil || body.header == nil {
+		return r, nil
+	}
+	decoded, err := decodeBody(body.header(), r)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	if decoded == io.Reader(r) {
+		return r, nil
+	}
+	return decodedReadCloser{
+		Reader: decoded,
+		closer: r,
+	}, nil
+}
+
+// mustDecodedReader is like decodedReader but fails the step if the body cannot be decoded
+func (body *HTTPBody) mustDecodedReader() io.ReadCloser {
+	r, err := body.decodedReader()
+	minitest.NoError(err, "failed to decode body")
+	return r
+}
+
+// RawReader returns the body as an reader in its wire form, without any decoding (same as Reader())
+func (body *HTTPBody) RawReader() io.ReadCloser {
 	if body.factory == nil {
 		return nil
 	}
 	return body.factory.NewDoppelganger()
 }
 
-// Bytes returns the body as a byte slice
+// streamReader returns a decoded reader that reads directly from the source, the data is not buffered so it will not
+// be available for other readers
+func (body *HTTPBody) streamReader() (io.ReadCloser, error) {
+	if body.source == nil {
+		return nil, nil
+	}
+	r := ioutil.NopCloser(body.source)
+	if body.header == nil {
+		return r, nil
+	}
+	decoded, err := decodeBody(body.header(), r)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to decode body: %w", err)
+	}
+	return decodedReadCloser{
+		Reader: decoded,
+		closer: r,
+	}, nil
+}
+
+// Bytes returns the body as a byte slice in its wire form, use String() to get the decoded body
 func (body *HTTPBody) Bytes() []byte {
-	r := body.Reader()
+	return readAllAndClose(body.RawReader())
+}
+
+// RawBytes returns the body as a byte slice in its wire form, without any decoding (same as Bytes())
+func (body *HTTPBody) RawBytes() []byte {
+	return readAllAndClose(body.RawReader())
+}
+
+// tryDecodedBytes returns the decoded body as a byte slice or an error if the body cannot be decoded
+func (body *HTTPBody) tryDecodedBytes() ([]byte, error) {
+	r, err := body.decodedReader()
+	if err != nil || r == nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// decodedBytes returns the decoded body as a byte slice, it fails the step if the body cannot be decoded
+func (body *HTTPBody) decodedBytes() []byte {
+	return readAllAndClose(body.mustDecodedReader())
+}
+
+func readAllAndClose(r io.ReadCloser) []byte {
 	if r == nil {
 		return nil
 	}
@@ -153,9 +239,10 @@ func (body *HTTPBody) Bytes() []byte {
 	return buf
 }
 
-// String returns the body as a string
+// String returns the body as a string, the body is decoded based on the Content-Encoding and the charset of the
+// Content-Type header
 func (body *HTTPBody) String() string {
-	return string(body.Bytes())
+	return string(body.decodedBytes())
 }
 
 // Int returns the body as an int
@@ -254,6 +341,51 @@ func (body *HTTPBody) JSON() *HTTPJson {
 	return newHTTPJson(body)
 }
 
+// XML returns the body as an xml
+func (body *HTTPBody) XML() *HTTPXml {
+	return newHTTPXml(body)
+}
+
+// NDJSON returns the body as newline delimited json
+func (body *HTTPBody) NDJSON() *HTTPNDJSON {
+	if body.ndjson == nil {
+		body.ndjson = newHTTPNDJSON(body)
+	}
+	return body.ndjson
+}
+
+// MsgPack returns the body as MessagePack
+func (body *HTTPBody) MsgPack() *HTTPCodec {
+	return newHTTPCodec(body, MsgPackContentType)
+}
+
+// Protobuf returns the body as protobuf, a codec for ProtobufContentType has to be registered with RegisterCodec
+func (body *HTTPBody) Protobuf() *HTTPCodec {
+	return newHTTPCodec(body, ProtobufContentType)
+}
+
+// Codec returns the body encoded with the codec that is registered for the specified Content-Type
+func (body *HTTPBody) Codec(contentType string) *HTTPCodec {
+	return newHTTPCodec(body, contentType)
+}
+
+// Decoded returns the body with the codec that is registered for the Content-Type of the body
+func (body *HTTPBody) Decoded() *HTTPCodec {
+	return newHTTPCodec(body, body.contentType())
+}
+
+func (body *HTTPBody) contentType() string {
+	if body.header == nil {
+		return ""
+	}
+	return body.header().Get("Content-Type")
+}
+
+// HTML returns the body as a html document
+func (body *HTTPBody) HTML() *HTTPHtml {
+	return newHTTPHtml(body)
+}
+
 func (body *HTTPBody) setOnlyNativeTypes(a interface{}) bool {
 	switch v := a.(type) {
 	case string:
@@ -303,13 +435,13 @@ func (body *HTTPBody) equalOnlyNativeTypes(a interface{}, equal bool) bool {
 	case string:
 		equalFunc(v, body.String())
 	case []byte:
-		equalFunc(v, body.Bytes())
+		equalFunc(v, body.decodedBytes())
 	case io.Reader:
 		buf, err := ioutil.ReadAll(v)
 		if err != nil {
 			minitest.Errorf("unable to read data from reader: %s", err.Error())
 		}
-		equalFunc(buf, body.Bytes())
+		equalFunc(buf, body.decodedBytes())
 	case int:
 		equalFunc(v, body.Int())
 	case int8:
@@ -361,12 +493,12 @@ func (body *HTTPBody) containsOnlyNativeTypes(a interface{}, equal bool) bool {
 	case []byte:
 		switch equal {
 		case true:
-			if !bytes.Contains(body.Bytes(), v) {
-				minitest.Errorf(`"%v" does not contain "%v"`, body.Bytes(), v)
+			if !bytes.Contains(body.decodedBytes(), v) {
+				minitest.Errorf(`"%v" does not contain "%v"`, body.decodedBytes(), v)
 			}
 		default:
-			if bytes.Contains(body.Bytes(), v) {
-				minitest.Errorf(`"%v" does contain "%v"`, body.Bytes(), v)
+			if bytes.Contains(body.decodedBytes(), v) {
+				minitest.Errorf(`"%v" does contain "%v"`, body.decodedBytes(), v)
 			}
 		}
 
@@ -0,0 +1,204 @@
+package hit
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/xerrors"
+)
+
+// ContentDecoder decodes a body that was encoded with a specific Content-Encoding
+type ContentDecoder func(r io.Reader) (io.Reader, error)
+
+// CharsetDecoder converts a body that was encoded with a specific charset into utf-8
+type CharsetDecoder func(r io.Reader) (io.Reader, error)
+
+//nolint:gochecknoglobals
+var (
+	decodersMu      sync.RWMutex
+	contentDecoders = map[string]ContentDecoder{
+		"identity": func(r io.Reader) (io.Reader, error) {
+			return r, nil
+		},
+		"gzip": func(r io.Reader) (io.Reader, error) {
+			return gzip.NewReader(r)
+		},
+		"x-gzip": func(r io.Reader) (io.Reader, error) {
+			return gzip.NewReader(r)
+		},
+		"deflate": decodeDeflate,
+		"br": func(r io.Reader) (io.Reader, error) {
+			return brotli.NewReader(r), nil
+		},
+		"zstd": decodeZstd,
+	}
+	charsetDecoders = map[string]CharsetDecoder{
+		"utf-8": func(r io.Reader) (io.Reader, error) {
+			return r, nil
+		},
+		"utf8": func(r io.Reader) (io.Reader, error) {
+			return r, nil
+		},
+		"us-ascii": func(r io.Reader) (io.Reader, error) {
+			return r, nil
+		},
+		"ascii": func(r io.Reader) (io.Reader, error) {
+			return r, nil
+		},
+		"iso-8859-1": decodeLatin1,
+		"latin1":     decodeLatin1,
+	}
+)
+
+// RegisterContentDecoder registers a decoder for the specified Content-Encoding, use it to add support for other
+// encodings or to replace a built in decoder.
+//
+// gzip, deflate, br and zstd are supported out of the box.
+//
+// Example:
+//     RegisterContentDecoder("lz4", func(r io.Reader) (io.Reader, error) {
+//         return lz4.NewReader(r), nil
+//     })
+func RegisterContentDecoder(encoding string, decoder ContentDecoder) {
+	decodersMu.Lock()
+	contentDecoders[strings.ToLower(encoding)] = decoder
+	decodersMu.Unlock()
+}
+
+// RegisterCharsetDecoder registers a decoder for the specified Content-Type charset.
+//
+// utf-8, us-ascii and iso-8859-1 are supported out of the box.
+//
+// Example:
+//     RegisterCharsetDecoder("windows-1252", func(r io.Reader) (io.Reader, error) {
+//         return charmap.Windows1252.NewDecoder().Reader(r), nil
+//     })
+func RegisterCharsetDecoder(charset string, decoder CharsetDecoder) {
+	decodersMu.Lock()
+	charsetDecoders[strings.ToLower(charset)] = decoder
+	decodersMu.Unlock()
+}
+
+func lookupContentDecoder(encoding string) (ContentDecoder, bool) {
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+	decoder, ok := contentDecoders[strings.ToLower(encoding)]
+	return decoder, ok
+}
+
+func lookupCharsetDecoder(charset string) (CharsetDecoder, bool) {
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+	decoder, ok := charsetDecoders[strings.ToLower(charset)]
+	return decoder, ok
+}
+
+// decodeDeflate decodes zlib wrapped deflate data, as specified by RFC 7230,
+// some servers send raw deflate data though, so fall back to that if there is no zlib header
+func decodeDeflate(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(2)
+	if err == nil && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(br)
+	}
+	return flate.NewReader(br), nil
+}
+
+// decodeZstd decodes zstd data, the decoder is closed together with the body
+func decodeZstd(r io.Reader) (io.Reader, error) {
+	decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return decoder.IOReadCloser(), nil
+}
+
+func decodeLatin1(r io.Reader) (io.Reader, error) {
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var sb strings.Builder
+	sb.Grow(len(buf))
+	for _, b := range buf {
+		sb.WriteRune(rune(b))
+	}
+	return strings.NewReader(sb.String()), nil
+}
+
+// decodeBody decodes the reader based on the Content-Encoding and Content-Type headers,
+// bodies with an unsupported charset are returned without charset decoding
+func decodeBody(header http.Header, r io.Reader) (io.Reader, error) {
+	if header == nil {
+		return r, nil
+	}
+
+	// responses to HEAD requests or 304 Not Modified have no body even if they carry a Content-Encoding
+	buffered := bufio.NewReader(r)
+	if _, err := buffered.Peek(1); err == io.EOF {
+		return buffered, nil
+	}
+	r = buffered
+
+	// encodings are listed in the order they were applied, so decode them in reverse order
+	encodings := strings.Split(strings.Join(header[http.CanonicalHeaderKey("Content-Encoding")], ","), ",")
+	for i := len(encodings) - 1; i >= 0; i-- {
+		encoding := strings.ToLower(strings.TrimSpace(encodings[i]))
+		if encoding == "" {
+			continue
+		}
+		decoder, ok := lookupContentDecoder(encoding)
+		if !ok {
+			return nil, xerrors.Errorf("unsupported Content-Encoding %s, use RegisterContentDecoder() to add support", encoding)
+		}
+		var err error
+		if r, err = decoder(r); err != nil {
+			return nil, xerrors.Errorf("unable to decode %s body: %w", encoding, err)
+		}
+	}
+
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		return r, nil
+	}
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		// ignore invalid content types
+		return r, nil
+	}
+	charset, ok := params["charset"]
+	if !ok {
+		return r, nil
+	}
+	decoder, ok := lookupCharsetDecoder(charset)
+	if !ok {
+		// use RegisterCharsetDecoder() to add support for the charset
+		return r, nil
+	}
+	if r, err = decoder(r); err != nil {
+		return nil, xerrors.Errorf("unable to decode %s body: %w", charset, err)
+	}
+	return r, nil
+}
+
+type decodedReadCloser struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (r decodedReadCloser) Close() error {
+	if c, ok := r.Reader.(io.Closer); ok {
+		_ = c.Close()
+	}
+	return r.closer.Close()
+}
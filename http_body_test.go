@@ -2,12 +2,19 @@ package hit_test
 
 import (
 	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"io/ioutil"
 
 	. "github.com/Eun/go-hit"
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 )
 
@@ -331,3 +338,196 @@ func TestHTTPBody_Contains(t *testing.T) {
 		)
 	})
 }
+
+func TestHTTPBody_Decode(t *testing.T) {
+	compress := func(newWriter func(w io.Writer) io.WriteCloser, s string) []byte {
+		var buf bytes.Buffer
+		w := newWriter(&buf)
+		_, err := w.Write([]byte(s))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	server := func(header http.Header, body []byte) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			for k, v := range header {
+				writer.Header()[k] = v
+			}
+			_, _ = writer.Write(body)
+		}))
+	}
+
+	t.Run("gzip", func(t *testing.T) {
+		body := compress(func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) }, `{"Name": "Joe"}`)
+		s := server(http.Header{"Content-Encoding": {"gzip"}, "Content-Type": {"application/json"}}, body)
+		defer s.Close()
+		Test(t,
+			Get(s.URL),
+			Send().Header("Accept-Encoding", "gzip"),
+			Expect().Header("Content-Encoding").Equal("gzip"),
+			Expect().Body().JSON().Equal("Name", "Joe"),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, `{"Name": "Joe"}`, hit.Response().Body().String())
+				require.Equal(t, body, hit.Response().Body().RawBytes())
+			}),
+		)
+	})
+
+	t.Run("deflate", func(t *testing.T) {
+		body := compress(func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) }, "Hello World")
+		s := server(http.Header{"Content-Encoding": {"deflate"}}, body)
+		defer s.Close()
+		Test(t,
+			Get(s.URL),
+			Expect().Body().Equal("Hello World"),
+		)
+	})
+
+	t.Run("raw deflate", func(t *testing.T) {
+		body := compress(func(w io.Writer) io.WriteCloser {
+			fw, err := flate.NewWriter(w, flate.DefaultCompression)
+			require.NoError(t, err)
+			return fw
+		}, "Hello World")
+		s := server(http.Header{"Content-Encoding": {"deflate"}}, body)
+		defer s.Close()
+		Test(t,
+			Get(s.URL),
+			Expect().Body().Equal("Hello World"),
+		)
+	})
+
+	t.Run("br", func(t *testing.T) {
+		body := compress(func(w io.Writer) io.WriteCloser { return brotli.NewWriter(w) }, "Hello World")
+		s := server(http.Header{"Content-Encoding": {"br"}}, body)
+		defer s.Close()
+		Test(t,
+			Get(s.URL),
+			Send().Header("Accept-Encoding", "br"),
+			Expect().Body().Equal("Hello World"),
+		)
+	})
+
+	t.Run("zstd", func(t *testing.T) {
+		body := compress(func(w io.Writer) io.WriteCloser {
+			zw, err := zstd.NewWriter(w)
+			require.NoError(t, err)
+			return zw
+		}, "Hello World")
+		s := server(http.Header{"Content-Encoding": {"zstd"}}, body)
+		defer s.Close()
+		Test(t,
+			Get(s.URL),
+			Send().Header("Accept-Encoding", "zstd"),
+			Expect().Body().Equal("Hello World"),
+		)
+	})
+
+	t.Run("empty gzip body", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.Header().Set("Content-Encoding", "gzip")
+			if request.Method == http.MethodGet {
+				writer.WriteHeader(http.StatusNotModified)
+			}
+		}))
+		defer s.Close()
+		Test(t,
+			Head(s.URL),
+			Send().Header("Accept-Encoding", "gzip"),
+			Expect().Body().Equal(""),
+		)
+		Test(t,
+			Get(s.URL),
+			Send().Header("Accept-Encoding", "gzip"),
+			Expect().Status().Equal(http.StatusNotModified),
+			Expect().Body().Equal(""),
+		)
+	})
+
+	t.Run("unsupported charset", func(t *testing.T) {
+		s := server(http.Header{"Content-Type": {"text/plain; charset=unknown"}}, []byte("Hello World"))
+		defer s.Close()
+		var buf bytes.Buffer
+		Test(t,
+			Get(s.URL),
+			Stdout(&buf),
+			Debug(),
+			Expect().Body().Equal("Hello World"),
+		)
+		require.Contains(t, buf.String(), "Hello World")
+	})
+
+	t.Run("latin1", func(t *testing.T) {
+		s := server(http.Header{"Content-Type": {"text/plain; charset=ISO-8859-1"}}, []byte{'G', 'r', 0xfc, 0xdf, 'e'})
+		defer s.Close()
+		Test(t,
+			Get(s.URL),
+			Expect().Body().Equal("Grüße"),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, []byte{'G', 'r', 0xfc, 0xdf, 'e'}, hit.Response().Body().RawBytes())
+			}),
+		)
+	})
+
+	t.Run("unsupported encoding", func(t *testing.T) {
+		s := server(http.Header{"Content-Encoding": {"unknown"}}, []byte("Hello World"))
+		defer s.Close()
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				Expect().Body().Equal("Hello World"),
+			),
+			PtrStr("failed to decode body"),
+			PtrStr("unsupported Content-Encoding unknown, use RegisterContentDecoder() to add support"),
+		)
+	})
+
+	t.Run("debug undecodable body", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			encoding string
+			body     []byte
+		}{
+			{"unsupported encoding", "compress", []byte("Hello World")},
+			{"corrupt gzip", "gzip", append(compress(func(w io.Writer) io.WriteCloser {
+				return gzip.NewWriter(w)
+			}, "Hello World")[:12], []byte("Hello World")...)},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				s := server(http.Header{"Content-Encoding": {tt.encoding}}, tt.body)
+				defer s.Close()
+				var buf bytes.Buffer
+				Test(t,
+					Get(s.URL),
+					Send().Header("Accept-Encoding", tt.encoding),
+					Stdout(&buf),
+					Debug(),
+					Expect().Custom(func(hit Hit) {
+						require.Equal(t, tt.body, hit.Response().Body().Bytes())
+					}),
+				)
+				require.Contains(t, buf.String(), "Hello World")
+			})
+		}
+	})
+
+	t.Run("registered decoder", func(t *testing.T) {
+		RegisterContentDecoder("reverse", func(r io.Reader) (io.Reader, error) {
+			buf, err := ioutil.ReadAll(r)
+			if err != nil {
+				return nil, err
+			}
+			for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+				buf[i], buf[j] = buf[j], buf[i]
+			}
+			return bytes.NewReader(buf), nil
+		})
+		s := server(http.Header{"Content-Encoding": {"reverse"}}, []byte("dlroW olleH"))
+		defer s.Close()
+		Test(t,
+			Get(s.URL),
+			Expect().Body().Equal("Hello World"),
+		)
+	})
+}
@@ -0,0 +1,68 @@
+package hit
+
+import (
+	"github.com/Eun/go-convert"
+	"github.com/Eun/go-hit/expr"
+	"github.com/Eun/go-hit/internal/minitest"
+)
+
+// HTTPCodec encodes and decodes the body with the codec that is registered for a specific Content-Type
+type HTTPCodec struct {
+	Hit
+	body        *HTTPBody
+	contentType string
+}
+
+func newHTTPCodec(body *HTTPBody, contentType string) *HTTPCodec {
+	return &HTTPCodec{
+		body:        body,
+		Hit:         body.hit,
+		contentType: contentType,
+	}
+}
+
+func (c *HTTPCodec) codec() Codec {
+	if c.contentType == "" {
+		minitest.Errorf("unable to decode body, there is no Content-Type")
+	}
+	codec, ok := lookupCodec(c.contentType)
+	if !ok {
+		minitest.Errorf("no codec registered for %s, use RegisterCodec() to register one", c.contentType)
+	}
+	return codec
+}
+
+// ContentType returns the Content-Type of the codec
+func (c *HTTPCodec) ContentType() string {
+	return c.contentType
+}
+
+// Decode decodes the body into the container
+func (c *HTTPCodec) Decode(container interface{}) {
+	minitest.NoError(c.codec().Unmarshal(c.body.decodedBytes(), container), "unable to decode body as %s", c.contentType)
+}
+
+// Get returns the value at the expression of the decoded body
+func (c *HTTPCodec) Get(expression string) interface{} {
+	var container interface{}
+	c.Decode(&container)
+	v, ok, err := expr.GetValue(container, expression, expr.IgnoreCase)
+	minitest.NoError(err)
+	if !ok {
+		v = nil
+	}
+	return v
+}
+
+// GetAs returns the value at the expression of the decoded body as the specified interface type
+func (c *HTTPCodec) GetAs(expression string, container interface{}) interface{} {
+	minitest.NoError(convert.Convert(c.Get(expression), container))
+	return container
+}
+
+// Set sets the body to the encoded data
+func (c *HTTPCodec) Set(data interface{}) {
+	buf, err := c.codec().Marshal(data)
+	minitest.NoError(err, "unable to encode body as %s", c.contentType)
+	c.body.SetBytes(buf)
+}
@@ -0,0 +1,86 @@
+package hit
+
+import (
+	"strings"
+
+	"github.com/Eun/go-hit/internal/dom"
+	"github.com/Eun/go-hit/internal/minitest"
+)
+
+type HTTPHtml struct {
+	Hit
+	body *HTTPBody
+}
+
+func newHTTPHtml(body *HTTPBody) *HTTPHtml {
+	return &HTTPHtml{
+		body: body,
+		Hit:  body.hit,
+	}
+}
+
+func (h *HTTPHtml) find(selector string) []*dom.Node {
+	doc, err := dom.ParseHTML(h.body.mustDecodedReader())
+	minitest.NoError(err)
+	nodes, err := doc.Select(selector)
+	minitest.NoError(err)
+	return nodes
+}
+
+// Count returns the amount of elements that match the css selector
+func (h *HTTPHtml) Count(selector string) int {
+	return len(h.find(selector))
+}
+
+// Text returns the text of the first element that matches the css selector,
+// leading and trailing whitespace is removed. If no element matches an empty string is returned.
+func (h *HTTPHtml) Text(selector string) string {
+	nodes := h.find(selector)
+	if len(nodes) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(nodes[0].Value())
+}
+
+// Texts returns the texts of all elements that match the css selector
+func (h *HTTPHtml) Texts(selector string) []string {
+	nodes := h.find(selector)
+	texts := make([]string, len(nodes))
+	for i := range nodes {
+		texts[i] = strings.TrimSpace(nodes[i].Value())
+	}
+	return texts
+}
+
+// Attr returns the value of the attribute of the first element that matches the css selector.
+// If no element matches or the element has no such attribute an empty string is returned.
+//
+// Example:
+//     var token string
+//     MustDo(
+//         Get("https://example.com/login"),
+//         Expect().Custom(func(hit Hit) {
+//             token = hit.Response().Body().HTML().Attr("form#login input[name=csrf]", "value")
+//         }),
+//     )
+func (h *HTTPHtml) Attr(selector, name string) string {
+	nodes := h.find(selector)
+	if len(nodes) == 0 {
+		return ""
+	}
+	v, _ := nodes[0].Attribute(strings.ToLower(name))
+	return v
+}
+
+// Attrs returns the values of the attribute of all elements that match the css selector,
+// elements that do not have the attribute are skipped.
+func (h *HTTPHtml) Attrs(selector, name string) []string {
+	nodes := h.find(selector)
+	values := make([]string, 0, len(nodes))
+	for i := range nodes {
+		if v, ok := nodes[i].Attribute(strings.ToLower(name)); ok {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -23,7 +23,7 @@ func newHTTPJson(body *HTTPBody) *HTTPJson {
 // Get returns the body as an interface type based on the underlying data
 func (jsn *HTTPJson) Get(expression string) interface{} {
 	var container interface{}
-	minitest.NoError(json.NewDecoder(jsn.body.Reader()).Decode(&container))
+	minitest.NoError(json.NewDecoder(jsn.body.mustDecodedReader()).Decode(&container))
 	v, ok, err := expr.GetValue(container, expression, expr.IgnoreCase)
 	minitest.NoError(err)
 	if !ok {
@@ -35,7 +35,7 @@ func (jsn *HTTPJson) Get(expression string) interface{} {
 // GetAs returns the body as the specified interface type
 func (jsn *HTTPJson) GetAs(expression string, container interface{}) interface{} {
 	var v interface{}
-	minitest.NoError(json.NewDecoder(jsn.body.Reader()).Decode(&v))
+	minitest.NoError(json.NewDecoder(jsn.body.mustDecodedReader()).Decode(&v))
 	v, ok, err := expr.GetValue(v, expression, expr.IgnoreCase)
 	minitest.NoError(err)
 	if !ok {
@@ -0,0 +1,102 @@
+package hit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// HTTPNDJSON provides access to a newline delimited json body (also known as json lines),
+// every non empty line of the body is a json value.
+type HTTPNDJSON struct {
+	body      *HTTPBody
+	streaming bool
+	consumed  bool
+}
+
+func newHTTPNDJSON(body *HTTPBody) *HTTPNDJSON {
+	return &HTTPNDJSON{
+		body: body,
+	}
+}
+
+// SetStreaming enables or disables the streaming mode.
+// In streaming mode the lines are read directly from the connection and are not kept in memory,
+// so the body can only be read once.
+func (n *HTTPNDJSON) SetStreaming(streaming bool) {
+	n.streaming = streaming
+}
+
+func (n *HTTPNDJSON) reader() (io.ReadCloser, error) {
+	if !n.streaming {
+		return n.body.mustDecodedReader(), nil
+	}
+	if n.consumed {
+		return nil, xerrors.New("the body was already read, in streaming mode the body can only be read once")
+	}
+	n.consumed = true
+	return n.body.streamReader()
+}
+
+// Lines calls fn for each non empty line of the body, the first line is 0.
+// The iteration stops if fn returns false.
+func (n *HTTPNDJSON) Lines(fn func(line int, data []byte) bool) error {
+	r, err := n.reader()
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		return nil
+	}
+	defer r.Close()
+
+	reader := bufio.NewReader(r)
+	line := 0
+	for {
+		data, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if data = bytes.TrimSpace(data); len(data) > 0 {
+			if !fn(line, data) {
+				return nil
+			}
+			line++
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// Len returns the amount of non empty lines
+func (n *HTTPNDJSON) Len() int {
+	size := 0
+	minitest.NoError(n.Lines(func(int, []byte) bool {
+		size++
+		return true
+	}))
+	return size
+}
+
+// Get returns the decoded value of the specified line, the first line is 0
+func (n *HTTPNDJSON) Get(line int) interface{} {
+	var container interface{}
+	found := false
+	minitest.NoError(n.Lines(func(i int, data []byte) bool {
+		if i != line {
+			return true
+		}
+		found = true
+		minitest.NoError(json.Unmarshal(data, &container), "line %d", line)
+		return false
+	}))
+	if !found {
+		minitest.Errorf("line %d does not exist", line)
+	}
+	return container
+}
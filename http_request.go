@@ -12,6 +12,8 @@ type HTTPRequest struct {
 	Hit Hit
 	*http.Request
 	body *HTTPBody
+	// jsonRPCCalls are the calls that were added with Send().JSONRPC()
+	jsonRPCCalls []interface{}
 }
 
 func newHTTPRequest(hit Hit, req *http.Request) *HTTPRequest {
@@ -88,6 +90,9 @@ func newHTTPRequest(hit Hit, req *http.Request) *HTTPRequest {
 		body: &HTTPBody{
 			hit:     hit,
 			factory: factory,
+			header: func() http.Header {
+				return newRequest.Header
+			},
 		},
 	}
 }
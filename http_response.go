@@ -8,13 +8,19 @@ type HTTPResponse struct {
 	Hit Hit
 	*http.Response
 	body *HTTPBody
+	sse  *HTTPSSE
+	ws   *HTTPWebSocket
+	// redirects are the responses of the redirects that were followed
+	redirects []*http.Response
 }
 
 func newHTTPResponse(hit Hit, response *http.Response) *HTTPResponse {
 	r := &HTTPResponse{
 		Hit:      hit,
 		Response: response,
-		body:     newHTTPBody(hit, response.Body),
+		body: newHTTPBody(hit, response.Body, func() http.Header {
+			return response.Header
+		}),
 	}
 	r.body.SetReader(response.Body)
 	return r
@@ -23,3 +29,39 @@ func newHTTPResponse(hit Hit, response *http.Response) *HTTPResponse {
 func (r *HTTPResponse) Body() *HTTPBody {
 	return r.body
 }
+
+// Redirects returns the responses of the redirects that were followed before this response was received,
+// in the order they occurred. The bodies of the responses are already closed.
+func (r *HTTPResponse) Redirects() []*http.Response {
+	return r.redirects
+}
+
+// SSE returns the server-sent event stream of the response
+func (r *HTTPResponse) SSE() *HTTPSSE {
+	if r.sse == nil {
+		r.sse = newHTTPSSE(r.body)
+	}
+	return r.sse
+}
+
+// WebSocket returns the websocket connection that was established by WebSocket()
+func (r *HTTPResponse) WebSocket() *HTTPWebSocket {
+	if r.ws == nil {
+		r.ws = newHTTPWebSocket(r.Hit.Request().Request, r.Response)
+	}
+	return r.ws
+}
+
+// close stops reading events, closes the websocket connection and closes the response body
+func (r *HTTPResponse) close() error {
+	if r.sse != nil {
+		r.sse.Close()
+	}
+	if r.ws != nil {
+		r.ws.Close()
+	}
+	if r.Response.Body == nil {
+		return nil
+	}
+	return r.Response.Body.Close()
+}
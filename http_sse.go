@@ -0,0 +1,206 @@
+package hit
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// DefaultSSETimeout is the default time to wait for the next server-sent event
+const DefaultSSETimeout = 10 * time.Second
+
+// SSEEvent is a server-sent event
+type SSEEvent struct {
+	// ID is the value of the id field
+	ID string
+	// Event is the value of the event field, it is "message" if the event had no event field
+	Event string
+	// Data is the value of all data fields joined by a newline
+	Data string
+	// Retry is the value of the retry field
+	Retry time.Duration
+}
+
+type sseResult struct {
+	event *SSEEvent
+	err   error
+}
+
+// HTTPSSE reads server-sent events (text/event-stream) from the response body as they arrive
+type HTTPSSE struct {
+	body      *HTTPBody
+	timeout   time.Duration
+	maxEvents int
+	count     int
+
+	once    sync.Once
+	events  chan sseResult
+	done    chan struct{}
+	closeMu sync.Mutex
+	closed  bool
+}
+
+func newHTTPSSE(body *HTTPBody) *HTTPSSE {
+	return &HTTPSSE{
+		body:    body,
+		timeout: DefaultSSETimeout,
+		done:    make(chan struct{}),
+	}
+}
+
+// SetTimeout sets the maximum time to wait for the next event
+func (sse *HTTPSSE) SetTimeout(timeout time.Duration) {
+	sse.timeout = timeout
+}
+
+// SetMaxEvents sets the maximum amount of events that can be read from the stream, 0 means unlimited
+func (sse *HTTPSSE) SetMaxEvents(maxEvents int) {
+	sse.maxEvents = maxEvents
+}
+
+// Next waits for the next event and returns it, it fails if the stream ended, the timeout elapsed or the maximum
+// amount of events was reached
+func (sse *HTTPSSE) Next() (*SSEEvent, error) {
+	if sse.maxEvents > 0 && sse.count >= sse.maxEvents {
+		return nil, xerrors.Errorf("maximum of %d events reached", sse.maxEvents)
+	}
+	sse.once.Do(sse.start)
+
+	var timeout <-chan time.Time
+	if sse.timeout > 0 {
+		timer := time.NewTimer(sse.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case result, ok := <-sse.events:
+		if !ok {
+			return nil, xerrors.New("event stream ended")
+		}
+		if result.err != nil {
+			return nil, result.err
+		}
+		sse.count++
+		return result.event, nil
+	case <-timeout:
+		return nil, xerrors.Errorf("no event received within %s", sse.timeout)
+	}
+}
+
+// Close stops reading events from the stream
+func (sse *HTTPSSE) Close() {
+	sse.closeMu.Lock()
+	defer sse.closeMu.Unlock()
+	if !sse.closed {
+		sse.closed = true
+		close(sse.done)
+	}
+}
+
+func (sse *HTTPSSE) start() {
+	sse.events = make(chan sseResult)
+	r := sse.body.mustDecodedReader()
+	go func() {
+		defer close(sse.events)
+		if r == nil {
+			return
+		}
+		defer r.Close()
+		reader := bufio.NewReader(r)
+		for {
+			event, err := readSSEEvent(reader)
+			if err == io.EOF {
+				return
+			}
+			select {
+			case sse.events <- sseResult{event: event, err: err}:
+			case <-sse.done:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// readSSELine reads a line that is terminated by \r\n, \n or \r
+func readSSELine(r *bufio.Reader) (string, error) {
+	var sb strings.Builder
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			if err == io.EOF && sb.Len() > 0 {
+				return sb.String(), nil
+			}
+			return "", err
+		}
+		switch c {
+		case '\n':
+			return sb.String(), nil
+		case '\r':
+			if next, err := r.Peek(1); err == nil && next[0] == '\n' {
+				_, _ = r.ReadByte()
+			}
+			return sb.String(), nil
+		}
+		sb.WriteByte(c)
+	}
+}
+
+// readSSEEvent reads the next event from the stream as specified in
+// https://html.spec.whatwg.org/multipage/server-sent-events.html#event-stream-interpretation
+func readSSEEvent(r *bufio.Reader) (*SSEEvent, error) {
+	var event SSEEvent
+	var data []string
+	hasData := false
+	for {
+		line, err := readSSELine(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if line == "" {
+			if !hasData {
+				// no data, reset the event and continue
+				event = SSEEvent{}
+				continue
+			}
+			event.Data = strings.Join(data, "\n")
+			if event.Event == "" {
+				event.Event = "message"
+			}
+			return &event, nil
+		}
+
+		if line[0] == ':' {
+			// comment
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+
+		switch field {
+		case "id":
+			event.ID = value
+		case "event":
+			event.Event = value
+		case "data":
+			data = append(data, value)
+			hasData = true
+		case "retry":
+			if ms, err := strconv.ParseUint(value, 10, 64); err == nil {
+				event.Retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package hit
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Eun/go-hit/internal/websocket"
+	"golang.org/x/xerrors"
+)
+
+// DefaultWebSocketTimeout is the default time to wait for the next websocket message
+const DefaultWebSocketTimeout = 10 * time.Second
+
+// WebSocketMessage is a message that was received on a websocket connection
+type WebSocketMessage struct {
+	// Binary is true if the message was a binary message, otherwise it was a text message
+	Binary bool
+	// Data is the payload of the message
+	Data []byte
+}
+
+type webSocketResult struct {
+	message *WebSocketMessage
+	err     error
+}
+
+// HTTPWebSocket is the websocket connection that was established by WebSocket()
+type HTTPWebSocket struct {
+	conn    *websocket.Conn
+	err     error
+	timeout time.Duration
+
+	once     sync.Once
+	messages chan webSocketResult
+	done     chan struct{}
+	closeMu  sync.Mutex
+	closed   bool
+}
+
+func newHTTPWebSocket(request *http.Request, response *http.Response) *HTTPWebSocket {
+	ws := &HTTPWebSocket{
+		timeout: DefaultWebSocketTimeout,
+		done:    make(chan struct{}),
+	}
+	if !strings.EqualFold(request.Header.Get("Upgrade"), "websocket") {
+		ws.err = xerrors.New("request is not a websocket request, did you use WebSocket()?")
+		return ws
+	}
+	// the handshake is validated lazily, so that a rejected handshake can still be inspected with Expect().Status()
+	if err := websocket.CheckHandshake(request.Header, response); err != nil {
+		ws.err = err
+		return ws
+	}
+	ws.conn = websocket.NewConn(response.Body.(io.ReadWriteCloser), true)
+	return ws
+}
+
+// SetTimeout sets the maximum time to wait for the next message
+func (ws *HTTPWebSocket) SetTimeout(timeout time.Duration) {
+	ws.timeout = timeout
+}
+
+// Next waits for the next message and returns it, it fails if the connection was closed or the timeout elapsed.
+// If the peer closed the connection the error contains the close code.
+func (ws *HTTPWebSocket) Next() (*WebSocketMessage, error) {
+	if ws.err != nil {
+		return nil, ws.err
+	}
+	ws.once.Do(ws.start)
+
+	var timeout <-chan time.Time
+	if ws.timeout > 0 {
+		timer := time.NewTimer(ws.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case result, ok := <-ws.messages:
+		if !ok {
+			return nil, xerrors.New("websocket connection ended")
+		}
+		if result.err != nil {
+			return nil, result.err
+		}
+		return result.message, nil
+	case <-timeout:
+		return nil, xerrors.Errorf("no message received within %s", ws.timeout)
+	}
+}
+
+// WriteText sends a text message
+func (ws *HTTPWebSocket) WriteText(s string) error {
+	if ws.err != nil {
+		return ws.err
+	}
+	return ws.conn.WriteMessage(websocket.TextMessage, []byte(s))
+}
+
+// WriteBinary sends a binary message
+func (ws *HTTPWebSocket) WriteBinary(data []byte) error {
+	if ws.err != nil {
+		return ws.err
+	}
+	return ws.conn.WriteMessage(websocket.BinaryMessage, data)
+}
+
+// WriteClose sends a close message with the specified code and reason
+func (ws *HTTPWebSocket) WriteClose(code int, reason string) error {
+	if ws.err != nil {
+		return ws.err
+	}
+	return ws.conn.WriteClose(code, reason)
+}
+
+// Close sends a normal close message (if none was sent already) and closes the connection
+func (ws *HTTPWebSocket) Close() {
+	ws.closeMu.Lock()
+	defer ws.closeMu.Unlock()
+	if ws.closed {
+		return
+	}
+	ws.closed = true
+	close(ws.done)
+	if ws.conn != nil {
+		_ = ws.conn.Close()
+	}
+}
+
+func (ws *HTTPWebSocket) start() {
+	ws.messages = make(chan webSocketResult)
+	go func() {
+		defer close(ws.messages)
+		for {
+			opcode, data, err := ws.conn.ReadMessage()
+			var result webSocketResult
+			if err != nil {
+				result.err = err
+			} else {
+				result.message = &WebSocketMessage{
+					Binary: opcode == websocket.BinaryMessage,
+					Data:   data,
+				}
+			}
+			select {
+			case ws.messages <- result:
+			case <-ws.done:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// webSocketCloseCode returns the close code of the error if the error was caused by the peer closing the connection
+func webSocketCloseCode(err error) (int, bool) {
+	var closeErr *websocket.CloseError
+	if xerrors.As(err, &closeErr) {
+		return closeErr.Code, true
+	}
+	return 0, false
+}
@@ -0,0 +1,88 @@
+package hit
+
+import (
+	"encoding/xml"
+
+	"github.com/Eun/go-convert"
+	"github.com/Eun/go-hit/internal/dom"
+	"github.com/Eun/go-hit/internal/minitest"
+)
+
+type HTTPXml struct {
+	Hit
+	body *HTTPBody
+}
+
+func newHTTPXml(body *HTTPBody) *HTTPXml {
+	return &HTTPXml{
+		body: body,
+		Hit:  body.hit,
+	}
+}
+
+func (x *HTTPXml) find(expression string) interface{} {
+	doc, err := dom.Parse(x.body.mustDecodedReader())
+	minitest.NoError(err)
+	if expression == "" {
+		expression = "/"
+	}
+	v, err := doc.XPath(expression)
+	minitest.NoError(err)
+	return v
+}
+
+// Nodes returns the string values of all nodes that were selected by the xpath expression
+func (x *HTTPXml) Nodes(expression string) []string {
+	v := x.find(expression)
+	nodes, ok := v.([]*dom.Node)
+	if !ok {
+		minitest.Errorf("xpath %s does not select nodes", expression)
+	}
+	values := make([]string, len(nodes))
+	for i := range nodes {
+		values[i] = nodes[i].Value()
+	}
+	return values
+}
+
+// Get returns the value that was selected by the xpath expression.
+//
+// If the expression selects nodes the result is nil if no node was selected, the string value of the node if one node
+// was selected or a slice with the string values if multiple nodes were selected.
+// Expressions that do not select nodes (e.g. count(//item)) return the result as is.
+func (x *HTTPXml) Get(expression string) interface{} {
+	v := x.find(expression)
+	nodes, ok := v.([]*dom.Node)
+	if !ok {
+		return v
+	}
+	switch len(nodes) {
+	case 0:
+		return nil
+	case 1:
+		return nodes[0].Value()
+	}
+	values := make([]string, len(nodes))
+	for i := range nodes {
+		values[i] = nodes[i].Value()
+	}
+	return values
+}
+
+// GetAs returns the value selected by the xpath expression as the specified interface type,
+// if the expression is empty the whole body will be unmarshalled into the container
+func (x *HTTPXml) GetAs(expression string, container interface{}) interface{} {
+	if expression == "" {
+		minitest.NoError(xml.NewDecoder(x.body.mustDecodedReader()).Decode(container))
+		return container
+	}
+	minitest.NoError(convert.Convert(x.Get(expression), container))
+	return container
+}
+
+// Set sets the body to the specified xml data
+func (x *HTTPXml) Set(data interface{}) {
+	buf, err := xml.Marshal(data)
+	minitest.NoError(err)
+	x.body.SetBytes(buf)
+}
@@ -0,0 +1,6 @@
+package internal
+
+// DoWithoutDefaults runs the specified steps (hit.IStep) without the steps of hit.Defaults(), it is set by the hit
+// package so sub packages (e.g. mock) can run steps that must not be influenced by the defaults of the user.
+//nolint:gochecknoglobals
+var DoWithoutDefaults func(steps []interface{}) error
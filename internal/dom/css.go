@@ -0,0 +1,507 @@
+package dom
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Select returns all descendant elements of the node that match the css selector, in document order.
+//
+// Supported are type (div), universal (*), id (#id), class (.class) and attribute selectors ([attr], [attr=value],
+// [attr~=value], [attr|=value], [attr^=value], [attr$=value], [attr*=value]), the descendant ( ), child (>),
+// adjacent sibling (+) and general sibling (~) combinators, selector lists (a, b) and the pseudo classes
+// :first-child, :last-child, :only-child, :nth-child(), :nth-last-child(), :first-of-type, :last-of-type, :empty
+// and :not().
+func (n *Node) Select(selector string) ([]*Node, error) {
+	p := cssParser{input: selector}
+	list, err := p.parse()
+	if err != nil {
+		return nil, xerrors.Errorf("unable to parse selector %q: %w", selector, err)
+	}
+
+	var nodes []*Node
+	var walk func(node *Node)
+	walk = func(node *Node) {
+		for _, child := range node.Children {
+			if child.Type != ElementNode {
+				continue
+			}
+			if list.matches(child) {
+				nodes = append(nodes, child)
+			}
+			walk(child)
+		}
+	}
+	walk(n)
+	return nodes, nil
+}
+
+type cssSelectorList []*cssComplexSelector
+
+func (list cssSelectorList) matches(node *Node) bool {
+	for _, sel := range list {
+		if sel.matches(node, len(sel.compounds)-1) {
+			return true
+		}
+	}
+	return false
+}
+
+// cssComplexSelector is a chain of compound selectors,
+// combinators[i] is the combinator between compounds[i] and compounds[i+1]
+type cssComplexSelector struct {
+	compounds   []*cssCompoundSelector
+	combinators []byte
+}
+
+func (sel *cssComplexSelector) matches(node *Node, i int) bool {
+	if !sel.compounds[i].matches(node) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	switch sel.combinators[i-1] {
+	case '>':
+		parent := node.Parent
+		return parent != nil && parent.Type == ElementNode && sel.matches(parent, i-1)
+	case '+':
+		prev := previousElement(node)
+		return prev != nil && sel.matches(prev, i-1)
+	case '~':
+		for prev := previousElement(node); prev != nil; prev = previousElement(prev) {
+			if sel.matches(prev, i-1) {
+				return true
+			}
+		}
+		return false
+	default:
+		for parent := node.Parent; parent != nil && parent.Type == ElementNode; parent = parent.Parent {
+			if sel.matches(parent, i-1) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+type cssCompoundSelector struct {
+	name    string
+	filters []func(node *Node) bool
+}
+
+func (sel *cssCompoundSelector) matches(node *Node) bool {
+	if node.Type != ElementNode {
+		return false
+	}
+	if sel.name != "" && sel.name != "*" && !strings.EqualFold(sel.name, node.Name) {
+		return false
+	}
+	for _, filter := range sel.filters {
+		if !filter(node) {
+			return false
+		}
+	}
+	return true
+}
+
+func siblingElements(node *Node) []*Node {
+	if node.Parent == nil {
+		return []*Node{node}
+	}
+	return node.Parent.Elements()
+}
+
+func previousElement(node *Node) *Node {
+	siblings := siblingElements(node)
+	for i := range siblings {
+		if siblings[i] == node && i > 0 {
+			return siblings[i-1]
+		}
+	}
+	return nil
+}
+
+// elementIndex returns the 1 based position of the node among its siblings,
+// if ofType is true only siblings with the same name are counted, if fromEnd is true the position is counted from the end
+func elementIndex(node *Node, ofType, fromEnd bool) int {
+	siblings := siblingElements(node)
+	if fromEnd {
+		for i, j := 0, len(siblings)-1; i < j; i, j = i+1, j-1 {
+			siblings[i], siblings[j] = siblings[j], siblings[i]
+		}
+	}
+	index := 0
+	for _, sibling := range siblings {
+		if ofType && sibling.Name != node.Name {
+			continue
+		}
+		index++
+		if sibling == node {
+			return index
+		}
+	}
+	return 0
+}
+
+// parser
+
+type cssParser struct {
+	input string
+	pos   int
+}
+
+func (p *cssParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *cssParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *cssParser) skipSpace() bool {
+	start := p.pos
+	for !p.eof() && isHTMLSpace(p.peek()) {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func isCSSNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c >= 0x80
+}
+
+func (p *cssParser) readName() (string, error) {
+	var sb strings.Builder
+	for !p.eof() {
+		c := p.peek()
+		if c == '\\' && p.pos+1 < len(p.input) {
+			sb.WriteByte(p.input[p.pos+1])
+			p.pos += 2
+			continue
+		}
+		if !isCSSNameChar(c) {
+			break
+		}
+		sb.WriteByte(c)
+		p.pos++
+	}
+	if sb.Len() == 0 {
+		if p.eof() {
+			return "", xerrors.New("expected a name but got the end of the selector")
+		}
+		return "", xerrors.Errorf("expected a name but got %q", p.peek())
+	}
+	return sb.String(), nil
+}
+
+func (p *cssParser) parse() (cssSelectorList, error) {
+	list, err := p.parseList()
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		return nil, xerrors.Errorf("unexpected %q", p.peek())
+	}
+	return list, nil
+}
+
+func (p *cssParser) parseList() (cssSelectorList, error) {
+	var list cssSelectorList
+	for {
+		p.skipSpace()
+		sel, err := p.parseComplex()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, sel)
+		p.skipSpace()
+		if p.peek() != ',' {
+			return list, nil
+		}
+		p.pos++
+	}
+}
+
+func (p *cssParser) parseComplex() (*cssComplexSelector, error) {
+	sel := &cssComplexSelector{}
+	for {
+		compound, err := p.parseCompound()
+		if err != nil {
+			return nil, err
+		}
+		sel.compounds = append(sel.compounds, compound)
+
+		space := p.skipSpace()
+		c := p.peek()
+		switch {
+		case c == '>' || c == '+' || c == '~':
+			p.pos++
+			p.skipSpace()
+			sel.combinators = append(sel.combinators, c)
+		case space && c != 0 && c != ',' && c != ')':
+			sel.combinators = append(sel.combinators, ' ')
+		default:
+			return sel, nil
+		}
+	}
+}
+
+func (p *cssParser) parseCompound() (*cssCompoundSelector, error) {
+	sel := &cssCompoundSelector{}
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		sel.name = "*"
+	case isCSSNameChar(c) || c == '\\':
+		name, err := p.readName()
+		if err != nil {
+			return nil, err
+		}
+		sel.name = name
+	}
+
+	for {
+		switch p.peek() {
+		case '#':
+			p.pos++
+			id, err := p.readName()
+			if err != nil {
+				return nil, err
+			}
+			sel.filters = append(sel.filters, func(node *Node) bool {
+				v, _ := node.Attribute("id")
+				return v == id
+			})
+		case '.':
+			p.pos++
+			class, err := p.readName()
+			if err != nil {
+				return nil, err
+			}
+			sel.filters = append(sel.filters, attributeFilter("class", "~=", class))
+		case '[':
+			p.pos++
+			filter, err := p.parseAttribute()
+			if err != nil {
+				return nil, err
+			}
+			sel.filters = append(sel.filters, filter)
+		case ':':
+			p.pos++
+			filter, err := p.parsePseudo()
+			if err != nil {
+				return nil, err
+			}
+			sel.filters = append(sel.filters, filter)
+		default:
+			if sel.name == "" && len(sel.filters) == 0 {
+				if p.eof() {
+					return nil, xerrors.New("expected a selector but got the end of the selector")
+				}
+				return nil, xerrors.Errorf("expected a selector but got %q", p.peek())
+			}
+			return sel, nil
+		}
+	}
+}
+
+func (p *cssParser) parseAttribute() (func(node *Node) bool, error) {
+	p.skipSpace()
+	name, err := p.readName()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.peek() == ']' {
+		p.pos++
+		return func(node *Node) bool {
+			_, ok := node.Attribute(strings.ToLower(name))
+			if !ok {
+				_, ok = node.Attribute(name)
+			}
+			return ok
+		}, nil
+	}
+
+	op := ""
+	for _, candidate := range []string{"=", "~=", "|=", "^=", "$=", "*="} {
+		if strings.HasPrefix(p.input[p.pos:], candidate) {
+			op = candidate
+		}
+	}
+	if op == "" {
+		return nil, xerrors.Errorf("expected an attribute operator but got %q", p.peek())
+	}
+	p.pos += len(op)
+	p.skipSpace()
+
+	var value string
+	if q := p.peek(); q == '"' || q == '\'' {
+		p.pos++
+		i := strings.IndexByte(p.input[p.pos:], q)
+		if i < 0 {
+			return nil, xerrors.New("unterminated string")
+		}
+		value = p.input[p.pos : p.pos+i]
+		p.pos += i + 1
+	} else {
+		value, err = p.readName()
+		if err != nil {
+			return nil, err
+		}
+	}
+	p.skipSpace()
+	if p.peek() != ']' {
+		return nil, xerrors.Errorf("expected ] but got %q", p.peek())
+	}
+	p.pos++
+	return attributeFilter(name, op, value), nil
+}
+
+func attributeFilter(name, op, value string) func(node *Node) bool {
+	return func(node *Node) bool {
+		v, ok := node.Attribute(strings.ToLower(name))
+		if !ok {
+			if v, ok = node.Attribute(name); !ok {
+				return false
+			}
+		}
+		switch op {
+		case "=":
+			return v == value
+		case "~=":
+			for _, field := range strings.Fields(v) {
+				if field == value {
+					return true
+				}
+			}
+			return false
+		case "|=":
+			return v == value || strings.HasPrefix(v, value+"-")
+		case "^=":
+			return value != "" && strings.HasPrefix(v, value)
+		case "$=":
+			return value != "" && strings.HasSuffix(v, value)
+		case "*=":
+			return value != "" && strings.Contains(v, value)
+		}
+		return false
+	}
+}
+
+func (p *cssParser) parsePseudo() (func(node *Node) bool, error) {
+	name, err := p.readName()
+	if err != nil {
+		return nil, err
+	}
+	name = strings.ToLower(name)
+	switch name {
+	case "first-child":
+		return func(node *Node) bool { return elementIndex(node, false, false) == 1 }, nil
+	case "last-child":
+		return func(node *Node) bool { return elementIndex(node, false, true) == 1 }, nil
+	case "only-child":
+		return func(node *Node) bool { return len(siblingElements(node)) == 1 }, nil
+	case "first-of-type":
+		return func(node *Node) bool { return elementIndex(node, true, false) == 1 }, nil
+	case "last-of-type":
+		return func(node *Node) bool { return elementIndex(node, true, true) == 1 }, nil
+	case "empty":
+		return func(node *Node) bool {
+			for _, child := range node.Children {
+				if child.Type == ElementNode || child.Data != "" {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case "nth-child", "nth-last-child":
+		arg, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		a, b, err := parseNth(arg)
+		if err != nil {
+			return nil, err
+		}
+		fromEnd := name == "nth-last-child"
+		return func(node *Node) bool {
+			index := elementIndex(node, false, fromEnd)
+			if a == 0 {
+				return index == b
+			}
+			k := index - b
+			return k%a == 0 && k/a >= 0
+		}, nil
+	case "not":
+		if p.peek() != '(' {
+			return nil, xerrors.New("expected ( after :not")
+		}
+		p.pos++
+		list, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.peek() != ')' {
+			return nil, xerrors.Errorf("expected ) but got %q", p.peek())
+		}
+		p.pos++
+		return func(node *Node) bool { return !list.matches(node) }, nil
+	}
+	return nil, xerrors.Errorf("unknown pseudo class :%s", name)
+}
+
+func (p *cssParser) parseArgument() (string, error) {
+	if p.peek() != '(' {
+		return "", xerrors.New("expected (")
+	}
+	i := strings.IndexByte(p.input[p.pos:], ')')
+	if i < 0 {
+		return "", xerrors.New("expected )")
+	}
+	arg := p.input[p.pos+1 : p.pos+i]
+	p.pos += i + 1
+	return strings.TrimSpace(arg), nil
+}
+
+// parseNth parses the an+b notation
+func parseNth(s string) (a, b int, err error) {
+	s = strings.ToLower(strings.Replace(s, " ", "", -1))
+	switch s {
+	case "odd":
+		return 2, 1, nil
+	case "even":
+		return 2, 0, nil
+	}
+	i := strings.IndexByte(s, 'n')
+	if i < 0 {
+		b, err = strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, xerrors.Errorf("invalid nth expression %q", s)
+		}
+		return 0, b, nil
+	}
+	switch s[:i] {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		if a, err = strconv.Atoi(s[:i]); err != nil {
+			return 0, 0, xerrors.Errorf("invalid nth expression %q", s)
+		}
+	}
+	if rest := s[i+1:]; rest != "" {
+		if b, err = strconv.Atoi(strings.TrimPrefix(rest, "+")); err != nil {
+			return 0, 0, xerrors.Errorf("invalid nth expression %q", s)
+		}
+	}
+	return a, b, nil
+}
@@ -0,0 +1,129 @@
+package dom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testHTML = `<!DOCTYPE html>
+<html>
+<head>
+	<title>Login &amp; more</title>
+	<script>if (a < b && c > d) { document.write("<p>no</p>") }</script>
+</head>
+<body>
+	<FORM id="login" class="form dark" action="/login">
+		<input type="hidden" name="csrf" value="token&amp;123">
+		<input type=text name=user disabled>
+		<input type="password" name="password"/>
+		<button class="btn btn-primary">Sign in</button>
+	</FORM>
+	<ul id="menu">
+		<li>Home
+		<li class="active">About
+		<li>Contact
+	</ul>
+	<p>First<p>Second
+	<!-- <div id="comment"></div> -->
+	<div lang="en-US"><span>a</span><em>b</em><span>c</span></div>
+</body>
+</html>`
+
+func selectValues(t *testing.T, doc *Node, selector string) []string {
+	nodes, err := doc.Select(selector)
+	require.NoError(t, err)
+	result := make([]string, len(nodes))
+	for i := range nodes {
+		result[i] = strings.TrimSpace(nodes[i].Value())
+		if v, ok := nodes[i].Attribute("name"); ok {
+			result[i] = v
+		}
+	}
+	return result
+}
+
+func TestParseHTML(t *testing.T) {
+	doc, err := ParseHTML(strings.NewReader(testHTML))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"Login & more"}, selectValues(t, doc, "title"))
+	require.Equal(t, []string{`if (a < b && c > d) { document.write("<p>no</p>") }`}, selectValues(t, doc, "script"))
+	require.Equal(t, []string{"First", "Second"}, selectValues(t, doc, "body > p"))
+	require.Empty(t, selectValues(t, doc, "#comment"))
+
+	inputs, err := doc.Select("form input")
+	require.NoError(t, err)
+	require.Len(t, inputs, 3)
+	v, _ := inputs[0].Attribute("value")
+	require.Equal(t, "token&123", v)
+	v, _ = inputs[1].Attribute("type")
+	require.Equal(t, "text", v)
+	_, ok := inputs[1].Attribute("disabled")
+	require.True(t, ok)
+}
+
+func TestSelect(t *testing.T) {
+	doc, err := ParseHTML(strings.NewReader(testHTML))
+	require.NoError(t, err)
+
+	tests := []struct {
+		selector string
+		expected []string
+	}{
+		{"form#login input[name=csrf]", []string{"csrf"}},
+		{"#login > input", []string{"csrf", "user", "password"}},
+		{"input[type='password']", []string{"password"}},
+		{"input[disabled]", []string{"user"}},
+		{"input[name^=pass]", []string{"password"}},
+		{"input[name$=er]", []string{"user"}},
+		{"input[name*=sr]", []string{"csrf"}},
+		{"[lang|=en] span", []string{"a", "c"}},
+		{".form.dark button.btn-primary", []string{"Sign in"}},
+		{".form .missing", []string{}},
+		{"ul li", []string{"Home", "About", "Contact"}},
+		{"li.active", []string{"About"}},
+		{"li:first-child", []string{"Home"}},
+		{"li:last-child", []string{"Contact"}},
+		{"li:nth-child(2)", []string{"About"}},
+		{"li:nth-child(odd)", []string{"Home", "Contact"}},
+		{"li:nth-child(-n+2)", []string{"Home", "About"}},
+		{"li:nth-last-child(1)", []string{"Contact"}},
+		{"li:not(.active)", []string{"Home", "Contact"}},
+		{"div span:first-of-type", []string{"a"}},
+		{"div span:last-of-type", []string{"c"}},
+		{"span + em", []string{"b"}},
+		{"span ~ span", []string{"c"}},
+		{"em, li.active", []string{"About", "b"}},
+		{"div > *", []string{"a", "b", "c"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.selector, func(t *testing.T) {
+			require.Equal(t, test.expected, selectValues(t, doc, test.selector))
+		})
+	}
+}
+
+func TestSelect_Errors(t *testing.T) {
+	doc, err := ParseHTML(strings.NewReader(testHTML))
+	require.NoError(t, err)
+
+	for _, selector := range []string{
+		"",
+		"div >",
+		"#",
+		"input[name",
+		"input[name=]",
+		"input[name='x]",
+		"li:unknown",
+		"li:nth-child(x)",
+		"div)",
+	} {
+		t.Run(selector, func(t *testing.T) {
+			_, err := doc.Select(selector)
+			require.Error(t, err)
+		})
+	}
+}
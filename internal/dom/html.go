@@ -0,0 +1,300 @@
+package dom
+
+import (
+	"html"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// voidElements are html elements that never have content and therefore no end tag
+//nolint:gochecknoglobals
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true, "input": true,
+	"link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+}
+
+// rawTextElements are html elements whose content is not parsed
+//nolint:gochecknoglobals
+var rawTextElements = map[string]bool{
+	"script": true, "style": true, "textarea": true, "title": true,
+}
+
+// implicitlyClosed lists for an element the open elements that get closed when the element starts,
+// e.g. a <li> closes a previous <li> that was not closed.
+//nolint:gochecknoglobals
+var implicitlyClosed = map[string][]string{
+	"li":       {"li"},
+	"dt":       {"dt", "dd"},
+	"dd":       {"dt", "dd"},
+	"tr":       {"tr", "td", "th"},
+	"td":       {"td", "th"},
+	"th":       {"td", "th"},
+	"option":   {"option"},
+	"optgroup": {"optgroup", "option"},
+	"p":        {"p"},
+	"div":      {"p"},
+	"ul":       {"p"},
+	"ol":       {"p"},
+	"table":    {"p"},
+	"form":     {"p"},
+	"h1":       {"p"},
+	"h2":       {"p"},
+	"h3":       {"p"},
+	"h4":       {"p"},
+	"h5":       {"p"},
+	"h6":       {"p"},
+}
+
+// scopeElements stop the search for elements that should be closed implicitly
+//nolint:gochecknoglobals
+var scopeElements = map[string]bool{
+	"ul": true, "ol": true, "dl": true, "table": true, "select": true, "html": true, "body": true,
+}
+
+// ParseHTML parses the html document from the reader.
+//
+// The parser is lenient like a browser: element and attribute names are lower cased, void elements (e.g. <input>)
+// do not need to be closed, unclosed elements are closed when their parent is closed and the content of script,
+// style, textarea and title elements is not parsed.
+func ParseHTML(r io.Reader) (*Node, error) {
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	p := htmlParser{
+		input: string(buf),
+		doc:   &Node{Type: DocumentNode},
+	}
+	p.current = p.doc
+	p.parse()
+	return p.doc, nil
+}
+
+type htmlParser struct {
+	input   string
+	pos     int
+	order   int
+	doc     *Node
+	current *Node
+}
+
+func (p *htmlParser) next() int {
+	p.order++
+	return p.order
+}
+
+func (p *htmlParser) parse() {
+	for p.pos < len(p.input) {
+		i := strings.IndexByte(p.input[p.pos:], '<')
+		if i < 0 {
+			p.text(p.input[p.pos:])
+			return
+		}
+		p.text(p.input[p.pos : p.pos+i])
+		p.pos += i
+		rest := p.input[p.pos:]
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			p.skipUntil("-->")
+		case strings.HasPrefix(rest, "<!"), strings.HasPrefix(rest, "<?"):
+			p.skipUntil(">")
+		case strings.HasPrefix(rest, "</"):
+			p.pos += 2
+			name := strings.ToLower(p.readName())
+			p.skipUntil(">")
+			p.closeElement(name)
+		case len(rest) > 1 && isHTMLNameStart(rest[1]):
+			p.pos++
+			p.startElement()
+		default:
+			// a lone < is text
+			p.text("<")
+			p.pos++
+		}
+	}
+}
+
+func (p *htmlParser) skipUntil(s string) {
+	i := strings.Index(p.input[p.pos:], s)
+	if i < 0 {
+		p.pos = len(p.input)
+		return
+	}
+	p.pos += i + len(s)
+}
+
+func (p *htmlParser) text(s string) {
+	if s == "" {
+		return
+	}
+	p.rawText(html.UnescapeString(s))
+}
+
+func (p *htmlParser) rawText(s string) {
+	if s == "" {
+		return
+	}
+	p.current.Children = append(p.current.Children, &Node{
+		Type:   TextNode,
+		Data:   s,
+		Parent: p.current,
+		order:  p.next(),
+	})
+}
+
+func isHTMLNameStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func (p *htmlParser) readName() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if isHTMLSpace(c) || c == '/' || c == '>' || c == '=' {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *htmlParser) skipSpace() {
+	for p.pos < len(p.input) && isHTMLSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *htmlParser) readAttributeValue() string {
+	if p.pos >= len(p.input) {
+		return ""
+	}
+	if q := p.input[p.pos]; q == '"' || q == '\'' {
+		p.pos++
+		i := strings.IndexByte(p.input[p.pos:], q)
+		if i < 0 {
+			v := p.input[p.pos:]
+			p.pos = len(p.input)
+			return html.UnescapeString(v)
+		}
+		v := p.input[p.pos : p.pos+i]
+		p.pos += i + 1
+		return html.UnescapeString(v)
+	}
+	start := p.pos
+	for p.pos < len(p.input) && !isHTMLSpace(p.input[p.pos]) && p.input[p.pos] != '>' {
+		p.pos++
+	}
+	return html.UnescapeString(p.input[start:p.pos])
+}
+
+func (p *htmlParser) startElement() {
+	name := strings.ToLower(p.readName())
+	p.closeImplicitly(name)
+
+	element := &Node{
+		Type:   ElementNode,
+		Name:   name,
+		Parent: p.current,
+		order:  p.next(),
+	}
+
+	selfClosing := false
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			break
+		}
+		if p.input[p.pos] == '>' {
+			p.pos++
+			break
+		}
+		if strings.HasPrefix(p.input[p.pos:], "/>") {
+			p.pos += 2
+			selfClosing = true
+			break
+		}
+		if p.input[p.pos] == '/' {
+			p.pos++
+			continue
+		}
+		attrName := strings.ToLower(p.readName())
+		if attrName == "" {
+			// invalid character, skip it
+			p.pos++
+			continue
+		}
+		p.skipSpace()
+		value := ""
+		if p.pos < len(p.input) && p.input[p.pos] == '=' {
+			p.pos++
+			p.skipSpace()
+			value = p.readAttributeValue()
+		}
+		element.Attributes = append(element.Attributes, &Node{
+			Type:   AttributeNode,
+			Name:   attrName,
+			Data:   value,
+			Parent: element,
+			order:  p.next(),
+		})
+	}
+
+	p.current.Children = append(p.current.Children, element)
+	if voidElements[name] || selfClosing {
+		return
+	}
+	p.current = element
+
+	if rawTextElements[name] {
+		end := "</" + name
+		i := strings.Index(strings.ToLower(p.input[p.pos:]), end)
+		if i < 0 {
+			i = len(p.input) - p.pos
+		}
+		content := p.input[p.pos : p.pos+i]
+		if name == "textarea" || name == "title" {
+			p.text(content)
+		} else {
+			p.rawText(content)
+		}
+		p.pos += i
+		if p.pos < len(p.input) {
+			p.skipUntil(">")
+		}
+		p.current = element.Parent
+	}
+}
+
+// closeImplicitly closes open elements that cannot contain the element that is about to start
+func (p *htmlParser) closeImplicitly(name string) {
+	closes, ok := implicitlyClosed[name]
+	if !ok {
+		return
+	}
+	for n := p.current; n != nil && n.Type == ElementNode; n = n.Parent {
+		for _, c := range closes {
+			if n.Name == c {
+				p.current = n.Parent
+				return
+			}
+		}
+		if scopeElements[n.Name] {
+			return
+		}
+	}
+}
+
+// closeElement closes the nearest open element with the name, end tags without a matching open element are ignored
+func (p *htmlParser) closeElement(name string) {
+	for n := p.current; n != nil && n.Type == ElementNode; n = n.Parent {
+		if n.Name == name {
+			p.current = n.Parent
+			return
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Package dom provides a minimal document tree for xml and html documents that can be queried with xpath expressions
+// and css selectors.
+package dom
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// NodeType is the type of a Node
+type NodeType uint8
+
+const (
+	DocumentNode NodeType = iota + 1
+	ElementNode
+	TextNode
+	AttributeNode
+)
+
+// Node is a node in the document tree
+type Node struct {
+	Type NodeType
+	// Name is the local name of an element or an attribute
+	Name string
+	// Data is the value of a text or attribute node
+	Data       string
+	Parent     *Node
+	Children   []*Node
+	Attributes []*Node
+
+	// order is the position of the node in the document, it is used to sort node sets
+	order int
+}
+
+// Parse parses the xml document from the reader
+func Parse(r io.Reader) (*Node, error) {
+	return parse(xml.NewDecoder(r))
+}
+
+func parse(decoder *xml.Decoder) (*Node, error) {
+	doc := &Node{
+		Type: DocumentNode,
+	}
+	order := 0
+	next := func() int {
+		order++
+		return order
+	}
+
+	current := doc
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return doc, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &Node{
+				Type:   ElementNode,
+				Name:   t.Name.Local,
+				Parent: current,
+				order:  next(),
+			}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					// namespace declarations are not attributes
+					continue
+				}
+				element.Attributes = append(element.Attributes, &Node{
+					Type:   AttributeNode,
+					Name:   attr.Name.Local,
+					Data:   attr.Value,
+					Parent: element,
+					order:  next(),
+				})
+			}
+			current.Children = append(current.Children, element)
+			current = element
+		case xml.EndElement:
+			if current.Parent != nil {
+				current = current.Parent
+			}
+		case xml.CharData:
+			current.Children = append(current.Children, &Node{
+				Type:   TextNode,
+				Data:   string(t),
+				Parent: current,
+				order:  next(),
+			})
+		}
+	}
+}
+
+// Value returns the string value of the node,
+// for elements and documents this is the concatenated text of all descendants
+func (n *Node) Value() string {
+	switch n.Type {
+	case TextNode, AttributeNode:
+		return n.Data
+	}
+	var sb strings.Builder
+	n.writeText(&sb)
+	return sb.String()
+}
+
+func (n *Node) writeText(sb *strings.Builder) {
+	for _, child := range n.Children {
+		switch child.Type {
+		case TextNode:
+			sb.WriteString(child.Data)
+		case ElementNode:
+			child.writeText(sb)
+		}
+	}
+}
+
+// Attribute returns the value of the specified attribute
+func (n *Node) Attribute(name string) (string, bool) {
+	for _, attr := range n.Attributes {
+		if attr.Name == name {
+			return attr.Data, true
+		}
+	}
+	return "", false
+}
+
+// Elements returns all child elements
+func (n *Node) Elements() []*Node {
+	var elements []*Node
+	for _, child := range n.Children {
+		if child.Type == ElementNode {
+			elements = append(elements, child)
+		}
+	}
+	return elements
+}
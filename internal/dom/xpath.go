@@ -0,0 +1,929 @@
+package dom
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/xerrors"
+)
+
+// XPath evaluates the xpath 1.0 expression on the node.
+// The result is either a node set ([]*Node), a string, a float64 or a bool.
+//
+// Supported are location paths with the child, descendant, descendant-or-self, self, parent, attribute, ancestor,
+// ancestor-or-self, following-sibling and preceding-sibling axis, the abbreviations (//, @, ., ..), predicates,
+// unions, comparisons, and/or and the most common functions (last, position, count, contains, starts-with, not,
+// string, number, concat, string-length, normalize-space, name, local-name, true, false)
+func (n *Node) XPath(expression string) (result interface{}, err error) {
+	p := xpathParser{lexer: newXPathLexer(expression)}
+	e, err := p.parse()
+	if err != nil {
+		return nil, xerrors.Errorf("unable to parse xpath %q: %w", expression, err)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			xerr, ok := r.(xpathError)
+			if !ok {
+				panic(r)
+			}
+			result = nil
+			err = xerrors.Errorf("unable to evaluate xpath %q: %s", expression, string(xerr))
+		}
+	}()
+	return e.eval(xpathContext{node: n, position: 1, size: 1}), nil
+}
+
+// Find evaluates the xpath expression on the node and returns the matched nodes
+func (n *Node) Find(expression string) ([]*Node, error) {
+	v, err := n.XPath(expression)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Node)
+	if !ok {
+		return nil, xerrors.Errorf("xpath %q does not select nodes", expression)
+	}
+	return nodes, nil
+}
+
+type xpathError string
+
+func evalErrorf(format string, a ...interface{}) {
+	panic(xpathError(fmt.Sprintf(format, a...)))
+}
+
+type xpathContext struct {
+	node     *Node
+	position int
+	size     int
+}
+
+type xpathExpr interface {
+	eval(ctx xpathContext) interface{}
+}
+
+// lexer
+
+type xpathTokenKind uint8
+
+const (
+	xpathEOF xpathTokenKind = iota
+	xpathSlash
+	xpathDoubleSlash
+	xpathLBracket
+	xpathRBracket
+	xpathLParen
+	xpathRParen
+	xpathAt
+	xpathComma
+	xpathDot
+	xpathDoubleDot
+	xpathStar
+	xpathPipe
+	xpathDoubleColon
+	xpathOperator
+	xpathName
+	xpathNumber
+	xpathLiteral
+)
+
+type xpathToken struct {
+	kind  xpathTokenKind
+	value string
+}
+
+type xpathLexer struct {
+	input  []rune
+	pos    int
+	tokens []xpathToken
+	err    error
+}
+
+func newXPathLexer(input string) *xpathLexer {
+	l := &xpathLexer{input: []rune(input)}
+	for {
+		t, err := l.next()
+		if err != nil {
+			l.err = err
+			return l
+		}
+		l.tokens = append(l.tokens, t)
+		if t.kind == xpathEOF {
+			return l
+		}
+	}
+}
+
+func isXPathNameRune(r rune, first bool) bool {
+	if unicode.IsLetter(r) || r == '_' {
+		return true
+	}
+	if first {
+		return false
+	}
+	return unicode.IsDigit(r) || r == '-' || r == '.' || r == ':'
+}
+
+//nolint:gocyclo
+func (l *xpathLexer) next() (xpathToken, error) {
+	for l.pos < len(l.input) && unicode.IsSpace(l.input[l.pos]) {
+		l.pos++
+	}
+	if l.pos >= len(l.input) {
+		return xpathToken{kind: xpathEOF}, nil
+	}
+	r := l.input[l.pos]
+	peek := func(s string) bool {
+		return strings.HasPrefix(string(l.input[l.pos:]), s)
+	}
+	single := func(kind xpathTokenKind, size int) (xpathToken, error) {
+		t := xpathToken{kind: kind, value: string(l.input[l.pos : l.pos+size])}
+		l.pos += size
+		return t, nil
+	}
+
+	switch {
+	case peek("//"):
+		return single(xpathDoubleSlash, 2)
+	case r == '/':
+		return single(xpathSlash, 1)
+	case r == '[':
+		return single(xpathLBracket, 1)
+	case r == ']':
+		return single(xpathRBracket, 1)
+	case r == '(':
+		return single(xpathLParen, 1)
+	case r == ')':
+		return single(xpathRParen, 1)
+	case r == '@':
+		return single(xpathAt, 1)
+	case r == ',':
+		return single(xpathComma, 1)
+	case peek(".."):
+		return single(xpathDoubleDot, 2)
+	case r == '.' && (l.pos+1 >= len(l.input) || !unicode.IsDigit(l.input[l.pos+1])):
+		return single(xpathDot, 1)
+	case r == '*':
+		return single(xpathStar, 1)
+	case r == '|':
+		return single(xpathPipe, 1)
+	case peek("::"):
+		return single(xpathDoubleColon, 2)
+	case peek("!="), peek("<="), peek(">="):
+		return single(xpathOperator, 2)
+	case r == '=' || r == '<' || r == '>':
+		return single(xpathOperator, 1)
+	case r == '"' || r == '\'':
+		end := l.pos + 1
+		for end < len(l.input) && l.input[end] != r {
+			end++
+		}
+		if end >= len(l.input) {
+			return xpathToken{}, xerrors.New("unterminated string literal")
+		}
+		t := xpathToken{kind: xpathLiteral, value: string(l.input[l.pos+1 : end])}
+		l.pos = end + 1
+		return t, nil
+	case unicode.IsDigit(r) || r == '.':
+		end := l.pos
+		for end < len(l.input) && (unicode.IsDigit(l.input[end]) || l.input[end] == '.') {
+			end++
+		}
+		return single(xpathNumber, end-l.pos)
+	case isXPathNameRune(r, true):
+		end := l.pos + 1
+		for end < len(l.input) && isXPathNameRune(l.input[end], false) {
+			// do not consume the axis separator
+			if l.input[end] == ':' && end+1 < len(l.input) && l.input[end+1] == ':' {
+				break
+			}
+			end++
+		}
+		return single(xpathName, end-l.pos)
+	}
+	return xpathToken{}, xerrors.Errorf("unexpected character %q at position %d", r, l.pos)
+}
+
+// parser
+
+type xpathParser struct {
+	lexer *xpathLexer
+	pos   int
+}
+
+func (p *xpathParser) peek() xpathToken {
+	return p.lexer.tokens[p.pos]
+}
+
+func (p *xpathParser) peekN(n int) xpathToken {
+	if p.pos+n >= len(p.lexer.tokens) {
+		return xpathToken{kind: xpathEOF}
+	}
+	return p.lexer.tokens[p.pos+n]
+}
+
+func (p *xpathParser) consume() xpathToken {
+	t := p.lexer.tokens[p.pos]
+	if t.kind != xpathEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *xpathParser) expect(kind xpathTokenKind, what string) error {
+	if t := p.consume(); t.kind != kind {
+		return xerrors.Errorf("expected %s but got %q", what, t.value)
+	}
+	return nil
+}
+
+func (p *xpathParser) parse() (xpathExpr, error) {
+	if p.lexer.err != nil {
+		return nil, p.lexer.err
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != xpathEOF {
+		return nil, xerrors.Errorf("unexpected %q", t.value)
+	}
+	return e, nil
+}
+
+func (p *xpathParser) parseOr() (xpathExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == xpathName && t.value == "or"; t = p.peek() {
+		p.consume()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &xpathLogical{or: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *xpathParser) parseAnd() (xpathExpr, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == xpathName && t.value == "and"; t = p.peek() {
+		p.consume()
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = &xpathLogical{or: false, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *xpathParser) parseComparison() (xpathExpr, error) {
+	left, err := p.parseUnion()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == xpathOperator {
+		op := p.consume().value
+		right, err := p.parseUnion()
+		if err != nil {
+			return nil, err
+		}
+		left = &xpathComparison{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *xpathParser) parseUnion() (xpathExpr, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == xpathPipe {
+		p.consume()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		left = &xpathUnion{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *xpathParser) parsePrimary() (xpathExpr, error) {
+	t := p.peek()
+	switch t.kind {
+	case xpathLiteral:
+		p.consume()
+		return xpathValue{value: t.value}, nil
+	case xpathNumber:
+		p.consume()
+		f, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid number %q", t.value)
+		}
+		return xpathValue{value: f}, nil
+	case xpathLParen:
+		p.consume()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(xpathRParen, ")"); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case xpathName:
+		if p.peekN(1).kind == xpathLParen && !isNodeTypeTest(t.value) {
+			return p.parseFunction()
+		}
+	}
+	return p.parsePath()
+}
+
+func isNodeTypeTest(name string) bool {
+	return name == "text" || name == "node"
+}
+
+func (p *xpathParser) parseFunction() (xpathExpr, error) {
+	fn := &xpathFunction{name: p.consume().value}
+	p.consume() // (
+	if p.peek().kind == xpathRParen {
+		p.consume()
+		return fn, fn.validate()
+	}
+	for {
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		fn.args = append(fn.args, arg)
+		if p.peek().kind == xpathComma {
+			p.consume()
+			continue
+		}
+		if err := p.expect(xpathRParen, ")"); err != nil {
+			return nil, err
+		}
+		return fn, fn.validate()
+	}
+}
+
+func (p *xpathParser) parsePath() (xpathExpr, error) {
+	path := &xpathPath{}
+	switch p.peek().kind {
+	case xpathSlash:
+		p.consume()
+		path.absolute = true
+		if !p.startsStep() {
+			// just the root
+			return path, nil
+		}
+	case xpathDoubleSlash:
+		p.consume()
+		path.absolute = true
+		path.steps = append(path.steps, descendantOrSelfStep())
+	}
+
+	for {
+		step, err := p.parseStep()
+		if err != nil {
+			return nil, err
+		}
+		path.steps = append(path.steps, step)
+
+		switch p.peek().kind {
+		case xpathSlash:
+			p.consume()
+		case xpathDoubleSlash:
+			p.consume()
+			path.steps = append(path.steps, descendantOrSelfStep())
+		default:
+			return path, nil
+		}
+	}
+}
+
+func (p *xpathParser) startsStep() bool {
+	switch p.peek().kind {
+	case xpathDot, xpathDoubleDot, xpathAt, xpathStar, xpathName:
+		return true
+	}
+	return false
+}
+
+type xpathAxis uint8
+
+const (
+	axisChild xpathAxis = iota
+	axisDescendant
+	axisDescendantOrSelf
+	axisSelf
+	axisParent
+	axisAttribute
+	axisAncestor
+	axisAncestorOrSelf
+	axisFollowingSibling
+	axisPrecedingSibling
+)
+
+//nolint:gochecknoglobals
+var xpathAxisNames = map[string]xpathAxis{
+	"child":              axisChild,
+	"descendant":         axisDescendant,
+	"descendant-or-self": axisDescendantOrSelf,
+	"self":               axisSelf,
+	"parent":             axisParent,
+	"attribute":          axisAttribute,
+	"ancestor":           axisAncestor,
+	"ancestor-or-self":   axisAncestorOrSelf,
+	"following-sibling":  axisFollowingSibling,
+	"preceding-sibling":  axisPrecedingSibling,
+}
+
+type xpathTestKind uint8
+
+const (
+	testName xpathTestKind = iota
+	testAny
+	testText
+	testNode
+)
+
+type xpathStep struct {
+	axis       xpathAxis
+	test       xpathTestKind
+	name       string
+	predicates []xpathExpr
+}
+
+func descendantOrSelfStep() *xpathStep {
+	return &xpathStep{axis: axisDescendantOrSelf, test: testNode}
+}
+
+func (p *xpathParser) parseStep() (*xpathStep, error) {
+	step := &xpathStep{axis: axisChild}
+	switch t := p.peek(); t.kind {
+	case xpathDot:
+		p.consume()
+		step.axis = axisSelf
+		step.test = testNode
+		return step, nil
+	case xpathDoubleDot:
+		p.consume()
+		step.axis = axisParent
+		step.test = testNode
+		return step, nil
+	case xpathAt:
+		p.consume()
+		step.axis = axisAttribute
+	case xpathName:
+		if p.peekN(1).kind == xpathDoubleColon {
+			axis, ok := xpathAxisNames[t.value]
+			if !ok {
+				return nil, xerrors.Errorf("unknown axis %q", t.value)
+			}
+			p.consume()
+			p.consume()
+			step.axis = axis
+		}
+	}
+
+	switch t := p.consume(); t.kind {
+	case xpathStar:
+		step.test = testAny
+	case xpathName:
+		if isNodeTypeTest(t.value) && p.peek().kind == xpathLParen {
+			p.consume()
+			if err := p.expect(xpathRParen, ")"); err != nil {
+				return nil, err
+			}
+			step.test = testNode
+			if t.value == "text" {
+				step.test = testText
+			}
+			break
+		}
+		step.test = testName
+		step.name = t.value
+		// ignore namespace prefixes
+		if i := strings.LastIndexByte(step.name, ':'); i >= 0 {
+			step.name = step.name[i+1:]
+		}
+	default:
+		return nil, xerrors.Errorf("expected a node test but got %q", t.value)
+	}
+
+	for p.peek().kind == xpathLBracket {
+		p.consume()
+		predicate, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(xpathRBracket, "]"); err != nil {
+			return nil, err
+		}
+		step.predicates = append(step.predicates, predicate)
+	}
+	return step, nil
+}
+
+// evaluation
+
+type xpathValue struct {
+	value interface{}
+}
+
+func (v xpathValue) eval(xpathContext) interface{} {
+	return v.value
+}
+
+type xpathPath struct {
+	absolute bool
+	steps    []*xpathStep
+}
+
+func (path *xpathPath) eval(ctx xpathContext) interface{} {
+	nodes := []*Node{ctx.node}
+	if path.absolute {
+		root := ctx.node
+		for root.Parent != nil {
+			root = root.Parent
+		}
+		nodes = []*Node{root}
+	}
+	for _, step := range path.steps {
+		var result []*Node
+		for _, node := range nodes {
+			result = append(result, step.eval(node)...)
+		}
+		nodes = sortNodes(result)
+	}
+	return nodes
+}
+
+func (step *xpathStep) eval(node *Node) []*Node {
+	var candidates []*Node
+	for _, candidate := range step.axisNodes(node) {
+		if step.matches(candidate) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	for _, predicate := range step.predicates {
+		var filtered []*Node
+		for i, candidate := range candidates {
+			v := predicate.eval(xpathContext{node: candidate, position: i + 1, size: len(candidates)})
+			if f, ok := v.(float64); ok {
+				if f == float64(i+1) {
+					filtered = append(filtered, candidate)
+				}
+				continue
+			}
+			if toBool(v) {
+				filtered = append(filtered, candidate)
+			}
+		}
+		candidates = filtered
+	}
+	return candidates
+}
+
+func (step *xpathStep) axisNodes(node *Node) []*Node {
+	switch step.axis {
+	case axisChild:
+		return node.Children
+	case axisDescendant:
+		return descendants(node, nil)
+	case axisDescendantOrSelf:
+		return descendants(node, []*Node{node})
+	case axisSelf:
+		return []*Node{node}
+	case axisParent:
+		if node.Parent != nil {
+			return []*Node{node.Parent}
+		}
+	case axisAttribute:
+		return node.Attributes
+	case axisAncestor, axisAncestorOrSelf:
+		var nodes []*Node
+		if step.axis == axisAncestorOrSelf {
+			nodes = append(nodes, node)
+		}
+		for parent := node.Parent; parent != nil; parent = parent.Parent {
+			nodes = append(nodes, parent)
+		}
+		return nodes
+	case axisFollowingSibling, axisPrecedingSibling:
+		if node.Parent == nil || node.Type == AttributeNode {
+			return nil
+		}
+		siblings := node.Parent.Children
+		for i := range siblings {
+			if siblings[i] != node {
+				continue
+			}
+			if step.axis == axisFollowingSibling {
+				return siblings[i+1:]
+			}
+			// reverse axis: nearest sibling first
+			nodes := make([]*Node, 0, i)
+			for j := i - 1; j >= 0; j-- {
+				nodes = append(nodes, siblings[j])
+			}
+			return nodes
+		}
+	}
+	return nil
+}
+
+func descendants(node *Node, nodes []*Node) []*Node {
+	for _, child := range node.Children {
+		nodes = append(nodes, child)
+		nodes = descendants(child, nodes)
+	}
+	return nodes
+}
+
+func (step *xpathStep) matches(node *Node) bool {
+	switch step.test {
+	case testNode:
+		return true
+	case testText:
+		return node.Type == TextNode
+	}
+	principal := ElementNode
+	if step.axis == axisAttribute {
+		principal = AttributeNode
+	}
+	if node.Type != principal {
+		return false
+	}
+	return step.test == testAny || node.Name == step.name
+}
+
+func sortNodes(nodes []*Node) []*Node {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].order < nodes[j].order
+	})
+	// remove duplicates
+	result := nodes[:0]
+	for i, node := range nodes {
+		if i > 0 && nodes[i-1] == node {
+			continue
+		}
+		result = append(result, node)
+	}
+	return result
+}
+
+type xpathUnion struct {
+	left, right xpathExpr
+}
+
+func (u *xpathUnion) eval(ctx xpathContext) interface{} {
+	left, ok1 := u.left.eval(ctx).([]*Node)
+	right, ok2 := u.right.eval(ctx).([]*Node)
+	if !ok1 || !ok2 {
+		evalErrorf("union operands must be node sets")
+	}
+	return sortNodes(append(append([]*Node{}, left...), right...))
+}
+
+type xpathLogical struct {
+	or          bool
+	left, right xpathExpr
+}
+
+func (l *xpathLogical) eval(ctx xpathContext) interface{} {
+	left := toBool(l.left.eval(ctx))
+	if l.or {
+		return left || toBool(l.right.eval(ctx))
+	}
+	return left && toBool(l.right.eval(ctx))
+}
+
+type xpathComparison struct {
+	op          string
+	left, right xpathExpr
+}
+
+func (c *xpathComparison) eval(ctx xpathContext) interface{} {
+	return compareValues(c.op, c.left.eval(ctx), c.right.eval(ctx))
+}
+
+func compareValues(op string, left, right interface{}) bool {
+	if nodes, ok := left.([]*Node); ok {
+		if _, ok := right.(bool); ok {
+			return compareAtomic(op, len(nodes) > 0, right)
+		}
+		for _, node := range nodes {
+			if compareValues(op, node.Value(), right) {
+				return true
+			}
+		}
+		return false
+	}
+	if nodes, ok := right.([]*Node); ok {
+		if _, ok := left.(bool); ok {
+			return compareAtomic(op, left, len(nodes) > 0)
+		}
+		for _, node := range nodes {
+			if compareValues(op, left, node.Value()) {
+				return true
+			}
+		}
+		return false
+	}
+	return compareAtomic(op, left, right)
+}
+
+func compareAtomic(op string, left, right interface{}) bool {
+	if op == "=" || op == "!=" {
+		var equal bool
+		_, lbool := left.(bool)
+		_, rbool := right.(bool)
+		_, lnum := left.(float64)
+		_, rnum := right.(float64)
+		switch {
+		case lbool || rbool:
+			equal = toBool(left) == toBool(right)
+		case lnum || rnum:
+			equal = toNumber(left) == toNumber(right)
+		default:
+			equal = toString(left) == toString(right)
+		}
+		if op == "=" {
+			return equal
+		}
+		return !equal
+	}
+
+	l := toNumber(left)
+	r := toNumber(right)
+	switch op {
+	case "<":
+		return l < r
+	case "<=":
+		return l <= r
+	case ">":
+		return l > r
+	case ">=":
+		return l >= r
+	}
+	return false
+}
+
+func toBool(v interface{}) bool {
+	switch x := v.(type) {
+	case bool:
+		return x
+	case float64:
+		return x != 0 && !math.IsNaN(x)
+	case string:
+		return x != ""
+	case []*Node:
+		return len(x) > 0
+	}
+	return false
+}
+
+func toNumber(v interface{}) float64 {
+	switch x := v.(type) {
+	case float64:
+		return x
+	case bool:
+		if x {
+			return 1
+		}
+		return 0
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(toString(v)), 64)
+	if err != nil {
+		return math.NaN()
+	}
+	return f
+}
+
+func toString(v interface{}) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case bool:
+		return strconv.FormatBool(x)
+	case float64:
+		if x == math.Trunc(x) && !math.IsInf(x, 0) {
+			return strconv.FormatInt(int64(x), 10)
+		}
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case []*Node:
+		if len(x) == 0 {
+			return ""
+		}
+		return x[0].Value()
+	}
+	return ""
+}
+
+type xpathFunction struct {
+	name string
+	args []xpathExpr
+}
+
+//nolint:gochecknoglobals
+var xpathFunctionArgs = map[string][2]int{
+	"last":            {0, 0},
+	"position":        {0, 0},
+	"count":           {1, 1},
+	"contains":        {2, 2},
+	"starts-with":     {2, 2},
+	"not":             {1, 1},
+	"string":          {0, 1},
+	"number":          {0, 1},
+	"concat":          {2, -1},
+	"string-length":   {0, 1},
+	"normalize-space": {0, 1},
+	"name":            {0, 1},
+	"local-name":      {0, 1},
+	"true":            {0, 0},
+	"false":           {0, 0},
+}
+
+func (fn *xpathFunction) validate() error {
+	args, ok := xpathFunctionArgs[fn.name]
+	if !ok {
+		return xerrors.Errorf("unknown function %s()", fn.name)
+	}
+	if len(fn.args) < args[0] || (args[1] >= 0 && len(fn.args) > args[1]) {
+		return xerrors.Errorf("invalid number of arguments for %s()", fn.name)
+	}
+	return nil
+}
+
+// arg returns the evaluated argument i, or the context node if the argument was omitted
+func (fn *xpathFunction) arg(ctx xpathContext, i int) interface{} {
+	if i < len(fn.args) {
+		return fn.args[i].eval(ctx)
+	}
+	return []*Node{ctx.node}
+}
+
+//nolint:gocyclo
+func (fn *xpathFunction) eval(ctx xpathContext) interface{} {
+	switch fn.name {
+	case "last":
+		return float64(ctx.size)
+	case "position":
+		return float64(ctx.position)
+	case "count":
+		nodes, ok := fn.arg(ctx, 0).([]*Node)
+		if !ok {
+			evalErrorf("count() expects a node set")
+		}
+		return float64(len(nodes))
+	case "contains":
+		return strings.Contains(toString(fn.arg(ctx, 0)), toString(fn.arg(ctx, 1)))
+	case "starts-with":
+		return strings.HasPrefix(toString(fn.arg(ctx, 0)), toString(fn.arg(ctx, 1)))
+	case "not":
+		return !toBool(fn.arg(ctx, 0))
+	case "string":
+		return toString(fn.arg(ctx, 0))
+	case "number":
+		return toNumber(fn.arg(ctx, 0))
+	case "concat":
+		var sb strings.Builder
+		for i := range fn.args {
+			sb.WriteString(toString(fn.arg(ctx, i)))
+		}
+		return sb.String()
+	case "string-length":
+		return float64(len([]rune(toString(fn.arg(ctx, 0)))))
+	case "normalize-space":
+		return strings.Join(strings.Fields(toString(fn.arg(ctx, 0))), " ")
+	case "name", "local-name":
+		nodes, ok := fn.arg(ctx, 0).([]*Node)
+		if !ok {
+			evalErrorf("%s() expects a node set", fn.name)
+		}
+		if len(nodes) == 0 {
+			return ""
+		}
+		return nodes[0].Name
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	evalErrorf("unknown function %s()", fn.name)
+	return nil
+}
@@ -0,0 +1,132 @@
+package dom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<library xmlns:b="urn:books">
+	<book id="1" lang="en">
+		<title>Go in Action</title>
+		<price>30.5</price>
+		<tags><tag>go</tag><tag>programming</tag></tags>
+	</book>
+	<book id="2" lang="de">
+		<title>Der Go Guide</title>
+		<price>20</price>
+	</book>
+	<b:book id="3">
+		<title><![CDATA[XML & You]]></title>
+		<price>10</price>
+	</b:book>
+</library>`
+
+func values(t *testing.T, doc *Node, expression string) []string {
+	nodes, err := doc.Find(expression)
+	require.NoError(t, err)
+	result := make([]string, len(nodes))
+	for i := range nodes {
+		result[i] = strings.TrimSpace(nodes[i].Value())
+	}
+	return result
+}
+
+func TestXPath_Paths(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDocument))
+	require.NoError(t, err)
+
+	tests := []struct {
+		expression string
+		expected   []string
+	}{
+		{"/library/book/title", []string{"Go in Action", "Der Go Guide", "XML & You"}},
+		{"//title", []string{"Go in Action", "Der Go Guide", "XML & You"}},
+		{"/library/book[1]/title", []string{"Go in Action"}},
+		{"/library/book[last()]/title", []string{"XML & You"}},
+		{"//book[@lang='de']/title", []string{"Der Go Guide"}},
+		{"//book[@lang!='de']/@id", []string{"1"}},
+		{"//book[price > 15]/@id", []string{"1", "2"}},
+		{"//book[price >= 10 and price < 30]/@id", []string{"2", "3"}},
+		{"//book[@id=1 or @id=3]/title", []string{"Go in Action", "XML & You"}},
+		{"//book[tags]/@id", []string{"1"}},
+		{"//book[not(tags)]/@id", []string{"2", "3"}},
+		{"//tag[2]", []string{"programming"}},
+		{"//tag/text()", []string{"go", "programming"}},
+		{"//book/@*", []string{"1", "en", "2", "de", "3"}},
+		{"//tag/..", []string{"goprogramming"}},
+		{"//tag[1]/following-sibling::tag", []string{"programming"}},
+		{"//tag[2]/preceding-sibling::*", []string{"go"}},
+		{"//tag[1]/ancestor::book/@id", []string{"1"}},
+		{"//title[contains(., 'Go')]", []string{"Go in Action", "Der Go Guide"}},
+		{"//title[starts-with(., 'Der')]", []string{"Der Go Guide"}},
+		{"//book[count(tags/tag) = 2]/@id", []string{"1"}},
+		{"//price | //title", []string{"Go in Action", "30.5", "Der Go Guide", "20", "XML & You", "10"}},
+		{"/library/*[position() = 2]/@id", []string{"2"}},
+		{"//unknown", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			require.Equal(t, test.expected, values(t, doc, test.expression))
+		})
+	}
+}
+
+func TestXPath_Values(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDocument))
+	require.NoError(t, err)
+
+	tests := []struct {
+		expression string
+		expected   interface{}
+	}{
+		{"count(//book)", float64(3)},
+		{"string(//book[2]/title)", "Der Go Guide"},
+		{"number(//book[1]/price)", 30.5},
+		{"concat(//book[1]/@id, '-', //book[2]/@id)", "1-2"},
+		{"string-length(//tag[1])", float64(2)},
+		{"normalize-space('  a   b ')", "a b"},
+		{"name(/library/*[3])", "book"},
+		{"//book[1]/price = 30.5", true},
+		{"true()", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			v, err := doc.XPath(test.expression)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, v)
+		})
+	}
+}
+
+func TestXPath_Errors(t *testing.T) {
+	doc, err := Parse(strings.NewReader(testDocument))
+	require.NoError(t, err)
+
+	for _, expression := range []string{
+		"//book[",
+		"//book[@id='1]",
+		"unknown()",
+		"count()",
+		"foo::book",
+		"//book]",
+		"count('a')",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := doc.XPath(expression)
+			require.Error(t, err)
+		})
+	}
+
+	_, err = doc.Find("count(//book)")
+	require.Error(t, err)
+}
+
+func TestParse_Error(t *testing.T) {
+	_, err := Parse(strings.NewReader("<a><b></a>"))
+	require.Error(t, err)
+}
@@ -105,6 +105,18 @@ func Empty(object interface{}, customMessageAndArgs ...interface{}) {
 	}
 }
 
+func NotEmpty(object interface{}, customMessageAndArgs ...interface{}) {
+	v := internal.GetValue(object)
+	switch v.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		if v.Len() == 0 {
+			panicNow(fmt.Sprintf(`%s should not be empty`, PrintValue(object)), customMessageAndArgs...)
+		}
+	default:
+		panicNow(fmt.Sprintf("called Len() on %s", PrintValue(object)))
+	}
+}
+
 func Len(object interface{}, length int, customMessageAndArgs ...interface{}) {
 	v := internal.GetValue(object)
 	switch v.Kind() {
@@ -0,0 +1,422 @@
+// Package msgpack implements the MessagePack format (https://github.com/msgpack/msgpack/blob/master/spec.md)
+// for the types that can be represented in json.
+package msgpack
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Marshal returns the MessagePack encoding of v.
+//
+// Structs are encoded as maps, the field names can be changed with the msgpack or json tag.
+func Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := encode(&buf, reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encode(buf *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() {
+		buf.WriteByte(0xc0)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			buf.WriteByte(0xc0)
+			return nil
+		}
+		return encode(buf, v.Elem())
+	case reflect.Bool:
+		if v.Bool() {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		encodeInt(buf, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		encodeUint(buf, v.Uint())
+	case reflect.Float32:
+		buf.WriteByte(0xca)
+		_ = binary.Write(buf, binary.BigEndian, math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		buf.WriteByte(0xcb)
+		_ = binary.Write(buf, binary.BigEndian, math.Float64bits(v.Float()))
+	case reflect.String:
+		encodeString(buf, v.String())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			buf.WriteByte(0xc0)
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			encodeBinary(buf, b)
+			return nil
+		}
+		encodeHeader(buf, v.Len(), 0x90, 0x0f, 0xdc, 0xdd)
+		for i := 0; i < v.Len(); i++ {
+			if err := encode(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			buf.WriteByte(0xc0)
+			return nil
+		}
+		keys := v.MapKeys()
+		// sort the keys to get a stable encoding
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		encodeHeader(buf, len(keys), 0x80, 0x0f, 0xde, 0xdf)
+		for _, key := range keys {
+			if err := encode(buf, key); err != nil {
+				return err
+			}
+			if err := encode(buf, v.MapIndex(key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		return encodeStruct(buf, v)
+	default:
+		return xerrors.Errorf("msgpack: unsupported type %s", v.Type().String())
+	}
+	return nil
+}
+
+type field struct {
+	name      string
+	index     int
+	omitEmpty bool
+}
+
+func structFields(t reflect.Type) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// unexported
+			continue
+		}
+		tag, ok := f.Tag.Lookup("msgpack")
+		if !ok {
+			tag = f.Tag.Get("json")
+		}
+		if tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+		if name == "" {
+			name = f.Name
+		}
+		omitEmpty := false
+		for _, p := range parts[1:] {
+			if p == "omitempty" {
+				omitEmpty = true
+			}
+		}
+		fields = append(fields, field{name: name, index: i, omitEmpty: omitEmpty})
+	}
+	return fields
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	default:
+		return v.IsValid() && v.Interface() == reflect.Zero(v.Type()).Interface()
+	}
+}
+
+func encodeStruct(buf *bytes.Buffer, v reflect.Value) error {
+	var fields []field
+	for _, f := range structFields(v.Type()) {
+		if f.omitEmpty && isEmpty(v.Field(f.index)) {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	encodeHeader(buf, len(fields), 0x80, 0x0f, 0xde, 0xdf)
+	for _, f := range fields {
+		encodeString(buf, f.name)
+		if err := encode(buf, v.Field(f.index)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeHeader writes the header of a string, array or map with the specified size
+func encodeHeader(buf *bytes.Buffer, size int, fix byte, fixMax int, code16, code32 byte) {
+	switch {
+	case size <= fixMax:
+		buf.WriteByte(fix | byte(size))
+	case size <= math.MaxUint16:
+		buf.WriteByte(code16)
+		_ = binary.Write(buf, binary.BigEndian, uint16(size))
+	default:
+		buf.WriteByte(code32)
+		_ = binary.Write(buf, binary.BigEndian, uint32(size))
+	}
+}
+
+func encodeString(buf *bytes.Buffer, s string) {
+	if len(s) <= 31 {
+		buf.WriteByte(0xa0 | byte(len(s)))
+	} else if len(s) <= math.MaxUint8 {
+		buf.WriteByte(0xd9)
+		buf.WriteByte(byte(len(s)))
+	} else {
+		encodeHeader(buf, len(s), 0, -1, 0xda, 0xdb)
+	}
+	buf.WriteString(s)
+}
+
+func encodeBinary(buf *bytes.Buffer, b []byte) {
+	switch {
+	case len(b) <= math.MaxUint8:
+		buf.WriteByte(0xc4)
+		buf.WriteByte(byte(len(b)))
+	case len(b) <= math.MaxUint16:
+		buf.WriteByte(0xc5)
+		_ = binary.Write(buf, binary.BigEndian, uint16(len(b)))
+	default:
+		buf.WriteByte(0xc6)
+		_ = binary.Write(buf, binary.BigEndian, uint32(len(b)))
+	}
+	buf.Write(b)
+}
+
+func encodeInt(buf *bytes.Buffer, i int64) {
+	switch {
+	case i >= 0:
+		encodeUint(buf, uint64(i))
+	case i >= -32:
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt8:
+		buf.WriteByte(0xd0)
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt16:
+		buf.WriteByte(0xd1)
+		_ = binary.Write(buf, binary.BigEndian, int16(i))
+	case i >= math.MinInt32:
+		buf.WriteByte(0xd2)
+		_ = binary.Write(buf, binary.BigEndian, int32(i))
+	default:
+		buf.WriteByte(0xd3)
+		_ = binary.Write(buf, binary.BigEndian, i)
+	}
+}
+
+func encodeUint(buf *bytes.Buffer, i uint64) {
+	switch {
+	case i <= 0x7f:
+		buf.WriteByte(byte(i))
+	case i <= math.MaxUint8:
+		buf.WriteByte(0xcc)
+		buf.WriteByte(byte(i))
+	case i <= math.MaxUint16:
+		buf.WriteByte(0xcd)
+		_ = binary.Write(buf, binary.BigEndian, uint16(i))
+	case i <= math.MaxUint32:
+		buf.WriteByte(0xce)
+		_ = binary.Write(buf, binary.BigEndian, uint32(i))
+	default:
+		buf.WriteByte(0xcf)
+		_ = binary.Write(buf, binary.BigEndian, i)
+	}
+}
+
+// Unmarshal decodes the MessagePack data into v.
+//
+// If v is an *interface{} maps are decoded as map[string]interface{}, arrays as []interface{}, integers as int64
+// (or uint64 if they do not fit) and binary data as []byte.
+// Other types are filled by converting the decoded value with encoding/json.
+func Unmarshal(data []byte, v interface{}) error {
+	d := decoder{data: data}
+	value, err := d.decode()
+	if err != nil {
+		return err
+	}
+	if d.pos != len(d.data) {
+		return xerrors.Errorf("msgpack: %d unexpected bytes after the value", len(d.data)-d.pos)
+	}
+	if p, ok := v.(*interface{}); ok {
+		*p = value
+		return nil
+	}
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, v)
+}
+
+type decoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *decoder) read(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.data) {
+		return nil, xerrors.New("msgpack: unexpected end of data")
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *decoder) readUint(n int) (uint64, error) {
+	b, err := d.read(n)
+	if err != nil {
+		return 0, err
+	}
+	var u uint64
+	for _, c := range b {
+		u = u<<8 | uint64(c)
+	}
+	return u, nil
+}
+
+//nolint:gocyclo
+func (d *decoder) decode() (interface{}, error) {
+	b, err := d.read(1)
+	if err != nil {
+		return nil, err
+	}
+	code := b[0]
+	switch {
+	case code <= 0x7f:
+		return int64(code), nil
+	case code >= 0xe0:
+		return int64(int8(code)), nil
+	case code&0xf0 == 0x80:
+		return d.decodeMap(int(code & 0x0f))
+	case code&0xf0 == 0x90:
+		return d.decodeArray(int(code & 0x0f))
+	case code&0xe0 == 0xa0:
+		return d.decodeString(int(code & 0x1f))
+	}
+
+	switch code {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		size, err := d.readUint(1 << (code - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.read(int(size))
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{}, b...), nil
+	case 0xca:
+		u, err := d.readUint(4)
+		return float64(math.Float32frombits(uint32(u))), err
+	case 0xcb:
+		u, err := d.readUint(8)
+		return math.Float64frombits(u), err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		u, err := d.readUint(1 << (code - 0xcc))
+		if err != nil {
+			return nil, err
+		}
+		if u > math.MaxInt64 {
+			return u, nil
+		}
+		return int64(u), nil
+	case 0xd0:
+		u, err := d.readUint(1)
+		return int64(int8(u)), err
+	case 0xd1:
+		u, err := d.readUint(2)
+		return int64(int16(u)), err
+	case 0xd2:
+		u, err := d.readUint(4)
+		return int64(int32(u)), err
+	case 0xd3:
+		u, err := d.readUint(8)
+		return int64(u), err
+	case 0xd9, 0xda, 0xdb:
+		size, err := d.readUint(1 << (code - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeString(int(size))
+	case 0xdc, 0xdd:
+		size, err := d.readUint(2 << (code - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeArray(int(size))
+	case 0xde, 0xdf:
+		size, err := d.readUint(2 << (code - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeMap(int(size))
+	}
+	return nil, xerrors.Errorf("msgpack: unsupported type 0x%02x", code)
+}
+
+func (d *decoder) decodeString(size int) (interface{}, error) {
+	b, err := d.read(size)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (d *decoder) decodeArray(size int) (interface{}, error) {
+	a := make([]interface{}, 0, size)
+	for i := 0; i < size; i++ {
+		v, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		a = append(a, v)
+	}
+	return a, nil
+}
+
+func (d *decoder) decodeMap(size int) (interface{}, error) {
+	m := make(map[string]interface{}, size)
+	for i := 0; i < size; i++ {
+		key, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		value, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		// keys are converted to strings, so the maps can be used like json objects
+		m[fmt.Sprint(key)] = value
+	}
+	return m, nil
+}
@@ -0,0 +1,100 @@
+package msgpack
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected []byte
+	}{
+		{"nil", nil, []byte{0xc0}},
+		{"true", true, []byte{0xc3}},
+		{"false", false, []byte{0xc2}},
+		{"positive fixint", 1, []byte{0x01}},
+		{"negative fixint", -1, []byte{0xff}},
+		{"uint8", 200, []byte{0xcc, 0xc8}},
+		{"uint16", 1000, []byte{0xcd, 0x03, 0xe8}},
+		{"int8", -100, []byte{0xd0, 0x9c}},
+		{"int16", -1000, []byte{0xd1, 0xfc, 0x18}},
+		{"float64", 1.5, []byte{0xcb, 0x3f, 0xf8, 0, 0, 0, 0, 0, 0}},
+		{"fixstr", "Joe", []byte{0xa3, 'J', 'o', 'e'}},
+		{"bin", []byte{1, 2}, []byte{0xc4, 0x02, 0x01, 0x02}},
+		{"fixarray", []int{1, 2}, []byte{0x92, 0x01, 0x02}},
+		{"fixmap", map[string]int{"a": 1}, []byte{0x81, 0xa1, 'a', 0x01}},
+		{"struct", struct {
+			Name  string `json:"name"`
+			Age   int    `msgpack:"age,omitempty"`
+			Other string `json:"-"`
+		}{Name: "Joe"}, []byte{0x81, 0xa4, 'n', 'a', 'm', 'e', 0xa3, 'J', 'o', 'e'}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf, err := Marshal(test.value)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, buf)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := Marshal(make(chan int))
+		require.EqualError(t, err, "msgpack: unsupported type chan int")
+	})
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Run("roundtrip", func(t *testing.T) {
+		value := map[string]interface{}{
+			"Name":   "Joe",
+			"Age":    int64(10),
+			"Big":    uint64(math.MaxUint64),
+			"Small":  int64(math.MinInt64),
+			"Score":  1.5,
+			"Admin":  true,
+			"Parent": nil,
+			"Roles":  []interface{}{"Admin", "User"},
+			"Data":   []byte{1, 2, 3},
+			"Long":   strings.Repeat("a", 300),
+			"Nested": map[string]interface{}{"ID": int64(-5)},
+		}
+		buf, err := Marshal(value)
+		require.NoError(t, err)
+
+		var v interface{}
+		require.NoError(t, Unmarshal(buf, &v))
+		require.Equal(t, value, v)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		type User struct {
+			Name  string
+			Roles []string
+		}
+		buf, err := Marshal(User{Name: "Joe", Roles: []string{"Admin"}})
+		require.NoError(t, err)
+
+		var user User
+		require.NoError(t, Unmarshal(buf, &user))
+		require.Equal(t, User{Name: "Joe", Roles: []string{"Admin"}}, user)
+	})
+
+	t.Run("integer keys", func(t *testing.T) {
+		var v interface{}
+		require.NoError(t, Unmarshal([]byte{0x81, 0x01, 0xa1, 'a'}, &v))
+		require.Equal(t, map[string]interface{}{"1": "a"}, v)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var v interface{}
+		require.EqualError(t, Unmarshal([]byte{0xa3, 'J'}, &v), "msgpack: unexpected end of data")
+		require.EqualError(t, Unmarshal([]byte{0xc1}, &v), "msgpack: unsupported type 0xc1")
+		require.EqualError(t, Unmarshal([]byte{0x01, 0x02}, &v), "msgpack: 1 unexpected bytes after the value")
+	})
+}
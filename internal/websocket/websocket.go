@@ -0,0 +1,372 @@
+// Package websocket implements the RFC 6455 framing that is needed to test websocket endpoints.
+package websocket
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // required by RFC 6455
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+// Opcodes as defined in RFC 6455 section 5.2
+const (
+	ContinuationMessage = 0x0
+	TextMessage         = 0x1
+	BinaryMessage       = 0x2
+	CloseMessage        = 0x8
+	PingMessage         = 0x9
+	PongMessage         = 0xA
+)
+
+// Close codes as defined in RFC 6455 section 7.4.1
+const (
+	CloseNormalClosure   = 1000
+	CloseGoingAway       = 1001
+	CloseProtocolError   = 1002
+	CloseNoStatusPresent = 1005
+)
+
+const (
+	finalBit = 1 << 7
+	maskBit  = 1 << 7
+
+	maxControlPayload = 125
+
+	// defaultMaxMessageSize limits the size of frames and reassembled messages, so a peer cannot make us allocate
+	// arbitrary amounts of memory
+	defaultMaxMessageSize = 32 << 20
+)
+
+//nolint:gochecknoglobals
+var keyGUID = []byte("258EAFA5-E914-47DA-95CA-C5AB0DC85B11")
+
+// CloseError is returned by ReadMessage if the peer closed the connection
+type CloseError struct {
+	Code   int
+	Reason string
+}
+
+func (e *CloseError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("websocket closed with code %d", e.Code)
+	}
+	return fmt.Sprintf("websocket closed with code %d: %s", e.Code, e.Reason)
+}
+
+// Conn is a websocket connection
+type Conn struct {
+	rw     io.ReadWriteCloser
+	br     *bufio.Reader
+	client bool
+
+	writeMu    sync.Mutex
+	closeSent  bool
+	closeError *CloseError
+
+	maxMessageSize uint64
+}
+
+// NewConn creates a websocket connection on top of an already upgraded connection,
+// client specifies whether this is the client side of the connection (client frames are masked)
+func NewConn(rw io.ReadWriteCloser, client bool) *Conn {
+	return &Conn{
+		rw:             rw,
+		br:             bufio.NewReader(rw),
+		client:         client,
+		maxMessageSize: defaultMaxMessageSize,
+	}
+}
+
+// NewKey returns a random Sec-WebSocket-Key
+func NewKey() (string, error) {
+	var key [16]byte
+	if _, err := io.ReadFull(rand.Reader, key[:]); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key[:]), nil
+}
+
+// AcceptKey returns the Sec-WebSocket-Accept value for the specified Sec-WebSocket-Key
+func AcceptKey(key string) string {
+	h := sha1.New() //nolint:gosec // required by RFC 6455
+	h.Write([]byte(key))
+	h.Write(keyGUID)
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// SetHandshakeHeaders sets the headers that are required to request an upgrade to a websocket connection
+func SetHandshakeHeaders(header http.Header) error {
+	key, err := NewKey()
+	if err != nil {
+		return err
+	}
+	header.Set("Connection", "Upgrade")
+	header.Set("Upgrade", "websocket")
+	header.Set("Sec-WebSocket-Version", "13")
+	header.Set("Sec-WebSocket-Key", key)
+	return nil
+}
+
+// CheckHandshake validates the servers handshake response
+func CheckHandshake(request http.Header, response *http.Response) error {
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		return xerrors.Errorf("websocket handshake failed: expected status %d, got %d", http.StatusSwitchingProtocols, response.StatusCode)
+	}
+	if !strings.EqualFold(response.Header.Get("Upgrade"), "websocket") {
+		return xerrors.Errorf("websocket handshake failed: invalid Upgrade header %q", response.Header.Get("Upgrade"))
+	}
+	if accept := AcceptKey(request.Get("Sec-WebSocket-Key")); response.Header.Get("Sec-WebSocket-Accept") != accept {
+		return xerrors.Errorf("websocket handshake failed: invalid Sec-WebSocket-Accept header %q", response.Header.Get("Sec-WebSocket-Accept"))
+	}
+	if _, ok := response.Body.(io.ReadWriteCloser); !ok {
+		return xerrors.New("websocket handshake failed: the response body is not writable")
+	}
+	return nil
+}
+
+// Upgrade upgrades the http request to a websocket connection, it is the server side of the handshake
+func Upgrade(w http.ResponseWriter, r *http.Request) (*Conn, error) {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		http.Error(w, "expected websocket upgrade", http.StatusBadRequest)
+		return nil, xerrors.New("expected websocket upgrade")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		http.Error(w, "missing Sec-WebSocket-Key", http.StatusBadRequest)
+		return nil, xerrors.New("missing Sec-WebSocket-Key")
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, xerrors.New("response writer does not support hijacking")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprint(rw, "HTTP/1.1 101 Switching Protocols\r\n")
+	fmt.Fprint(rw, "Upgrade: websocket\r\n")
+	fmt.Fprint(rw, "Connection: Upgrade\r\n")
+	fmt.Fprintf(rw, "Sec-WebSocket-Accept: %s\r\n\r\n", AcceptKey(key))
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	c := NewConn(conn, false)
+	c.br = rw.Reader
+	return c, nil
+}
+
+// WriteMessage writes a message with the specified opcode in a single frame
+func (c *Conn) WriteMessage(opcode int, data []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if c.closeSent {
+		return xerrors.New("websocket is closed")
+	}
+	if opcode == CloseMessage {
+		c.closeSent = true
+	}
+	return c.writeFrame(opcode, data)
+}
+
+// WriteClose sends a close frame with the specified code and reason
+func (c *Conn) WriteClose(code int, reason string) error {
+	payload := make([]byte, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, uint16(code))
+	copy(payload[2:], reason)
+	return c.WriteMessage(CloseMessage, payload)
+}
+
+func (c *Conn) writeFrame(opcode int, data []byte) error {
+	header := make([]byte, 2, 14)
+	header[0] = finalBit | byte(opcode)
+
+	size := len(data)
+	switch {
+	case size <= maxControlPayload:
+		header[1] = byte(size)
+	case size <= 0xFFFF:
+		header[1] = 126
+		header = append(header, 0, 0)
+		binary.BigEndian.PutUint16(header[2:], uint16(size))
+	default:
+		header[1] = 127
+		header = append(header, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(header[2:], uint64(size))
+	}
+
+	payload := data
+	if c.client {
+		header[1] |= maskBit
+		var mask [4]byte
+		if _, err := io.ReadFull(rand.Reader, mask[:]); err != nil {
+			return err
+		}
+		header = append(header, mask[:]...)
+		payload = make([]byte, size)
+		for i := range data {
+			payload[i] = data[i] ^ mask[i%4]
+		}
+	}
+
+	if _, err := c.rw.Write(append(header, payload...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+type frame struct {
+	final   bool
+	opcode  int
+	payload []byte
+}
+
+func (c *Conn) readFrame() (*frame, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.br, header[:]); err != nil {
+		return nil, err
+	}
+	f := frame{
+		final:  header[0]&finalBit != 0,
+		opcode: int(header[0] & 0x0F),
+	}
+	if header[0]&0x70 != 0 {
+		return nil, xerrors.New("websocket protocol error: reserved bits are set")
+	}
+	masked := header[1]&maskBit != 0
+	size := uint64(header[1] &^ maskBit)
+	switch size {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return nil, err
+		}
+		size = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.br, ext[:]); err != nil {
+			return nil, err
+		}
+		size = binary.BigEndian.Uint64(ext[:])
+	}
+
+	// control frames must not be fragmented and their payload is limited (RFC 6455 section 5.5)
+	if f.opcode&CloseMessage != 0 {
+		if !f.final {
+			return nil, xerrors.New("websocket protocol error: fragmented control frame")
+		}
+		if size > maxControlPayload {
+			return nil, xerrors.Errorf("websocket protocol error: control frame payload of %d bytes exceeds %d bytes", size, maxControlPayload)
+		}
+	}
+	if size > c.maxMessageSize {
+		return nil, xerrors.Errorf("websocket frame of %d bytes exceeds the maximum message size of %d bytes", size, c.maxMessageSize)
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.br, mask[:]); err != nil {
+			return nil, err
+		}
+	}
+
+	f.payload = make([]byte, size)
+	if _, err := io.ReadFull(c.br, f.payload); err != nil {
+		return nil, err
+	}
+	if masked {
+		for i := range f.payload {
+			f.payload[i] ^= mask[i%4]
+		}
+	}
+	return &f, nil
+}
+
+// ReadMessage reads the next text or binary message, fragmented messages are reassembled.
+// Ping frames are answered automatically, if the peer closes the connection a *CloseError is returned.
+func (c *Conn) ReadMessage() (int, []byte, error) {
+	if c.closeError != nil {
+		return 0, nil, c.closeError
+	}
+	opcode := -1
+	data := []byte{}
+	for {
+		f, err := c.readFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+		switch f.opcode {
+		case PingMessage:
+			if err := c.WriteMessage(PongMessage, f.payload); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case PongMessage:
+			continue
+		case CloseMessage:
+			c.closeError = parseClose(f.payload)
+			// answer the close frame, if we did not already
+			c.writeMu.Lock()
+			if !c.closeSent {
+				c.closeSent = true
+				_ = c.writeFrame(CloseMessage, f.payload)
+			}
+			c.writeMu.Unlock()
+			return 0, nil, c.closeError
+		case ContinuationMessage:
+			if opcode == -1 {
+				return 0, nil, xerrors.New("websocket protocol error: unexpected continuation frame")
+			}
+		case TextMessage, BinaryMessage:
+			if opcode != -1 {
+				return 0, nil, xerrors.New("websocket protocol error: expected continuation frame")
+			}
+			opcode = f.opcode
+		default:
+			return 0, nil, xerrors.Errorf("websocket protocol error: unknown opcode %d", f.opcode)
+		}
+
+		if uint64(len(data))+uint64(len(f.payload)) > c.maxMessageSize {
+			return 0, nil, xerrors.Errorf("websocket message exceeds the maximum message size of %d bytes", c.maxMessageSize)
+		}
+		data = append(data, f.payload...)
+		if f.final {
+			if opcode == TextMessage && !utf8.Valid(data) {
+				return 0, nil, xerrors.New("websocket protocol error: invalid utf-8 in text message")
+			}
+			return opcode, data, nil
+		}
+	}
+}
+
+func parseClose(payload []byte) *CloseError {
+	if len(payload) < 2 {
+		return &CloseError{Code: CloseNoStatusPresent}
+	}
+	return &CloseError{
+		Code:   int(binary.BigEndian.Uint16(payload)),
+		Reason: string(payload[2:]),
+	}
+}
+
+// Close sends a normal close frame (if none was sent already) and closes the underlying connection
+func (c *Conn) Close() error {
+	c.writeMu.Lock()
+	if !c.closeSent {
+		c.closeSent = true
+		payload := make([]byte, 2)
+		binary.BigEndian.PutUint16(payload, CloseNormalClosure)
+		_ = c.writeFrame(CloseMessage, payload)
+	}
+	c.writeMu.Unlock()
+	return c.rw.Close()
+}
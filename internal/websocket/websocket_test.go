@@ -0,0 +1,184 @@
+package websocket
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptKey(t *testing.T) {
+	// example from RFC 6455 section 1.3
+	require.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", AcceptKey("dGhlIHNhbXBsZSBub25jZQ=="))
+}
+
+// pipe returns two connected websocket connections,
+// a tcp connection is used because writes on a net.Pipe block until the peer reads them
+func pipe(t *testing.T) (*Conn, *Conn) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	accepted := make(chan net.Conn)
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			panic(err)
+		}
+		accepted <- c
+	}()
+
+	a, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	return NewConn(a, true), NewConn(<-accepted, false)
+}
+
+func TestConn_Messages(t *testing.T) {
+	client, server := pipe(t)
+	defer client.Close()
+	defer server.Close()
+
+	long := strings.Repeat("x", 70000)
+	messages := []struct {
+		opcode int
+		data   []byte
+	}{
+		{TextMessage, []byte("Hello World")},
+		{BinaryMessage, []byte{0, 1, 2, 3}},
+		{TextMessage, bytes.Repeat([]byte("a"), 300)},
+		{TextMessage, []byte(long)},
+		{TextMessage, []byte{}},
+	}
+
+	go func() {
+		for _, m := range messages {
+			if err := client.WriteMessage(m.opcode, m.data); err != nil {
+				panic(err)
+			}
+		}
+	}()
+
+	for _, m := range messages {
+		opcode, data, err := server.ReadMessage()
+		require.NoError(t, err)
+		require.Equal(t, m.opcode, opcode)
+		require.Equal(t, len(m.data), len(data))
+		require.Equal(t, m.data, data)
+	}
+}
+
+func TestConn_Fragmented(t *testing.T) {
+	client, server := pipe(t)
+	defer client.Close()
+	defer server.Close()
+
+	go func() {
+		_ = server.writeFrame(TextMessage, []byte("Hello"))
+	}()
+	// the first frame has the final bit set, so it should be a complete message
+	opcode, data, err := client.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, TextMessage, opcode)
+	require.Equal(t, "Hello", string(data))
+
+	// write a fragmented message with a ping in between
+	go func() {
+		frames := [][]byte{
+			{byte(TextMessage), 3, 'H', 'e', 'l'},
+			{finalBit | byte(PingMessage), 1, 'p'},
+			{finalBit | byte(ContinuationMessage), 2, 'l', 'o'},
+		}
+		for _, f := range frames {
+			_, _ = server.rw.Write(f)
+		}
+	}()
+
+	pong := make(chan []byte)
+	go func() {
+		f, err := server.readFrame()
+		if err != nil {
+			panic(err)
+		}
+		pong <- append([]byte{byte(f.opcode)}, f.payload...)
+	}()
+
+	opcode, data, err = client.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, TextMessage, opcode)
+	require.Equal(t, "Hello", string(data))
+	require.Equal(t, []byte{PongMessage, 'p'}, <-pong)
+}
+
+func TestConn_Close(t *testing.T) {
+	client, server := pipe(t)
+	defer client.Close()
+
+	go func() {
+		_ = server.WriteClose(4000, "bye")
+		// read the close answer
+		_, _, _ = server.ReadMessage()
+		_ = server.rw.Close()
+	}()
+
+	_, _, err := client.ReadMessage()
+	require.Equal(t, &CloseError{Code: 4000, Reason: "bye"}, err)
+	require.EqualError(t, err, "websocket closed with code 4000: bye")
+
+	// all following reads return the same error
+	_, _, err = client.ReadMessage()
+	require.Equal(t, &CloseError{Code: 4000, Reason: "bye"}, err)
+
+	require.Error(t, client.WriteMessage(TextMessage, []byte("Hello")))
+}
+
+func TestConn_Limits(t *testing.T) {
+	read := func(t *testing.T, maxMessageSize uint64, frames ...[]byte) error {
+		client, server := pipe(t)
+		defer client.Close()
+		defer server.Close()
+		client.maxMessageSize = maxMessageSize
+
+		go func() {
+			for _, f := range frames {
+				_, _ = server.rw.Write(f)
+			}
+		}()
+		_, _, err := client.ReadMessage()
+		return err
+	}
+
+	t.Run("frame exceeds the maximum size", func(t *testing.T) {
+		// announces a payload of 1 TiB without sending it
+		err := read(t, defaultMaxMessageSize, []byte{finalBit | byte(BinaryMessage), 127, 0, 0, 1, 0, 0, 0, 0, 0})
+		require.EqualError(t, err, "websocket frame of 1099511627776 bytes exceeds the maximum message size of 33554432 bytes")
+	})
+
+	t.Run("fragments exceed the maximum size", func(t *testing.T) {
+		err := read(t, 4,
+			[]byte{byte(TextMessage), 3, 'H', 'e', 'l'},
+			[]byte{finalBit | byte(ContinuationMessage), 2, 'l', 'o'},
+		)
+		require.EqualError(t, err, "websocket message exceeds the maximum message size of 4 bytes")
+	})
+
+	t.Run("control frame too long", func(t *testing.T) {
+		err := read(t, defaultMaxMessageSize, append([]byte{finalBit | byte(PingMessage), 126, 0, 126}, bytes.Repeat([]byte("p"), 126)...))
+		require.EqualError(t, err, "websocket protocol error: control frame payload of 126 bytes exceeds 125 bytes")
+	})
+
+	t.Run("fragmented control frame", func(t *testing.T) {
+		err := read(t, defaultMaxMessageSize, []byte{byte(PingMessage), 1, 'p'})
+		require.EqualError(t, err, "websocket protocol error: fragmented control frame")
+	})
+}
+
+func TestConn_ReadError(t *testing.T) {
+	a, b := net.Pipe()
+	client := NewConn(a, true)
+	_ = b.Close()
+	_, _, err := client.ReadMessage()
+	require.Equal(t, io.EOF, err)
+}
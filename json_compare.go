@@ -0,0 +1,206 @@
+package hit
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/Eun/go-hit/internal/minitest"
+	"github.com/google/go-cmp/cmp"
+)
+
+// JSONOption configures how json values are compared by Expect().Body().JSON().Equal() and
+// Expect().Body().JSON().Subset().
+type JSONOption func(*jsonCompareOptions)
+
+type jsonCompareOptions struct {
+	ignorePaths [][]string
+	ignoreOrder [][]string
+}
+
+// IgnorePaths ignores the specified paths when comparing json values.
+//
+// The paths are relative to the compared value, use * to match any key or array index. Keys are case sensitive, like
+// the keys of the compared values.
+//
+// Usage:
+//     Expect().Body().JSON().Equal("", expected, IgnorePaths("createdAt", "items.*.id"))
+func IgnorePaths(paths ...string) JSONOption {
+	return func(opts *jsonCompareOptions) {
+		for _, path := range paths {
+			opts.ignorePaths = append(opts.ignorePaths, splitJSONPath(path))
+		}
+	}
+}
+
+// IgnoreOrder ignores the order of the elements in the arrays at the specified paths when comparing json values.
+//
+// The paths are relative to the compared value, use * to match any key or array index and an empty path for the
+// compared value itself. Keys are case sensitive, like the keys of the compared values.
+//
+// Usage:
+//     Expect().Body().JSON().Equal("", expected, IgnoreOrder("tags", "items.*.roles"))
+func IgnoreOrder(paths ...string) JSONOption {
+	return func(opts *jsonCompareOptions) {
+		for _, path := range paths {
+			opts.ignoreOrder = append(opts.ignoreOrder, splitJSONPath(path))
+		}
+	}
+}
+
+func newJSONCompareOptions(opts []JSONOption) *jsonCompareOptions {
+	var o jsonCompareOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return &o
+}
+
+func splitJSONPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, ".")
+}
+
+// matchJSONPath reports whether the path matches the pattern, keys are matched case sensitive like align() and
+// the comparison match object keys
+func matchJSONPath(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAnyJSONPath(patterns [][]string, path []string) bool {
+	for _, pattern := range patterns {
+		if matchJSONPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendJSONPath(path []string, name string) []string {
+	p := make([]string, len(path), len(path)+1)
+	copy(p, path)
+	return append(p, name)
+}
+
+// normalizeJSON converts the value into the types encoding/json uses when decoding into an interface{}
+func normalizeJSON(v interface{}) interface{} {
+	buf, err := json.Marshal(v)
+	minitest.NoError(err)
+	var container interface{}
+	minitest.NoError(json.Unmarshal(buf, &container))
+	return container
+}
+
+// removeJSONPaths returns a copy of v without the values matching the ignored paths
+func (opts *jsonCompareOptions) removeJSONPaths(v interface{}, path []string) interface{} {
+	if len(opts.ignorePaths) == 0 {
+		return v
+	}
+	switch t := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for key, value := range t {
+			p := appendJSONPath(path, key)
+			if matchAnyJSONPath(opts.ignorePaths, p) {
+				continue
+			}
+			m[key] = opts.removeJSONPaths(value, p)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, value := range t {
+			s[i] = opts.removeJSONPaths(value, appendJSONPath(path, strconv.Itoa(i)))
+		}
+		return s
+	}
+	return v
+}
+
+// align returns the actual value shaped like the expected value, so that a cmp.Diff between both only shows the
+// relevant differences:
+// if subset is true all object keys that are not present in expected are removed from actual,
+// arrays that should be compared order insensitive are reordered to match the order of expected.
+func (opts *jsonCompareOptions) align(expected, actual interface{}, path []string, subset bool) interface{} {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+		m := make(map[string]interface{}, len(a))
+		for key, value := range a {
+			ev, ok := e[key]
+			if !ok {
+				if !subset {
+					m[key] = value
+				}
+				continue
+			}
+			m[key] = opts.align(ev, value, appendJSONPath(path, key), subset)
+		}
+		return m
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			return actual
+		}
+		if !matchAnyJSONPath(opts.ignoreOrder, path) {
+			s := make([]interface{}, len(a))
+			for i := range a {
+				p := appendJSONPath(path, strconv.Itoa(i))
+				if i < len(e) {
+					s[i] = opts.align(e[i], a[i], p, subset)
+				} else {
+					s[i] = a[i]
+				}
+			}
+			return s
+		}
+
+		// find a matching actual element for every expected element
+		s := make([]interface{}, 0, len(a))
+		used := make([]bool, len(a))
+		for i := range e {
+			for j := range a {
+				if used[j] {
+					continue
+				}
+				aligned := opts.align(e[i], a[j], appendJSONPath(path, strconv.Itoa(j)), subset)
+				if cmp.Equal(e[i], aligned) {
+					s = append(s, aligned)
+					used[j] = true
+					break
+				}
+			}
+		}
+		// put the remaining elements at the end, so the diff shows them as mismatches
+		for j := range a {
+			if !used[j] {
+				s = append(s, a[j])
+			}
+		}
+		return s
+	}
+	return actual
+}
+
+// compareJSON fails if the actual value does not equal the expected value (or does not contain it if subset is true)
+func compareJSON(expected, actual interface{}, subset bool, options []JSONOption) {
+	opts := newJSONCompareOptions(options)
+	e := opts.removeJSONPaths(normalizeJSON(expected), nil)
+	a := opts.removeJSONPaths(normalizeJSON(actual), nil)
+	minitest.Equal(e, opts.align(e, a, nil, subset))
+}
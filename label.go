@@ -0,0 +1,168 @@
+package hit
+
+import (
+	"os"
+	"strings"
+)
+
+// labeledStep is a step that was added by Name() or Tag(), it carries the names and tags of all groups it is part of
+type labeledStep struct {
+	IStep
+	name string
+	tags []string
+}
+
+func (s *labeledStep) exec(hit Hit) error {
+	if s.name == "" {
+		return s.IStep.exec(hit)
+	}
+	// add the name to the description so it is part of the error
+	description := hit.Description()
+	named := joinNames(description, s.name)
+	hit.SetDescription(named)
+	err := s.IStep.exec(hit)
+	// keep the description if the step changed it (e.g. Description())
+	if hit.Description() == named {
+		hit.SetDescription(description)
+	}
+	return err
+}
+
+func joinNames(a, b string) string {
+	if a == "" {
+		return b
+	}
+	if b == "" {
+		return a
+	}
+	return a + " - " + b
+}
+
+// StepName returns the name of the step that was set with Name(), if the step is part of multiple named groups the
+// names are joined. Use it in OnStep() hooks to report the steps.
+func StepName(step IStep) string {
+	if s, ok := step.(*labeledStep); ok {
+		return s.name
+	}
+	return ""
+}
+
+// StepTags returns the tags of the step that were set with Tag().
+func StepTags(step IStep) []string {
+	if s, ok := step.(*labeledStep); ok {
+		return s.tags
+	}
+	return nil
+}
+
+// labelGroupStep is the step of Name() and Tag()
+type labelGroupStep struct {
+	*hitStep
+}
+
+// labelGroup returns a step that adds the steps labeled with the name and the tags (and the labels of its own group)
+// to the steps
+func labelGroup(cleanPath clearPath, name string, tags []string, steps []IStep) IStep {
+	return &labelGroupStep{&hitStep{
+		Trace:     ett.Prepare(),
+		When:      CombineStep,
+		ClearPath: cleanPath,
+		Exec: func(hit Hit) error {
+			stepName, stepTags := name, tags
+			// inherit the labels if this group is part of another group
+			if parent, ok := hit.CurrentStep().(*labeledStep); ok {
+				stepName = joinNames(parent.name, name)
+				stepTags = append(append([]string(nil), parent.tags...), tags...)
+			}
+			if len(steps) == 0 && !tagsEnabled(stepTags) {
+				return skipError{reason: "tagged " + strings.Join(stepTags, ", ")}
+			}
+			labeled := make([]IStep, 0, len(steps))
+			for _, step := range steps {
+				// nested groups are filtered with their own tags
+				if _, ok := step.(*labelGroupStep); !ok && !tagsEnabled(stepTags) {
+					continue
+				}
+				labeled = append(labeled, &labeledStep{
+					IStep: step,
+					name:  stepName,
+					tags:  stepTags,
+				})
+			}
+			hit.InsertSteps(labeled...)
+			return nil
+		},
+	}}
+}
+
+// tagsEnabled reports whether steps with the tags should run, steps run if none of their tags is listed in HIT_SKIP_TAGS
+// and (if HIT_TAGS is set) one of their tags is listed in HIT_TAGS. Steps without tags always run.
+func tagsEnabled(tags []string) bool {
+	if len(tags) == 0 {
+		return true
+	}
+	skip := splitTags(os.Getenv("HIT_SKIP_TAGS"))
+	for _, tag := range tags {
+		if skip[tag] {
+			return false
+		}
+	}
+	only := splitTags(os.Getenv("HIT_TAGS"))
+	if len(only) == 0 {
+		return true
+	}
+	for _, tag := range tags {
+		if only[tag] {
+			return true
+		}
+	}
+	return false
+}
+
+func splitTags(s string) map[string]bool {
+	m := make(map[string]bool)
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			m[tag] = true
+		}
+	}
+	return m
+}
+
+// Name names the specified steps, the name is part of the description in the errors and in the Debug() output of
+// the steps. Names of nested groups are joined.
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com/users?page=2"),
+//         Name("checks pagination",
+//             Expect().Header("X-Page").Equal(2),
+//             Expect().Body().JSON().Len("", 10),
+//         ),
+//     )
+func Name(name string, steps ...IStep) IStep {
+	return labelGroup(newClearPath("Name", []interface{}{name}), name, nil, steps)
+}
+
+// Tag tags the specified steps, tagged steps can be filtered with the HIT_TAGS and HIT_SKIP_TAGS environment
+// variables (comma separated lists of tags):
+// if HIT_SKIP_TAGS contains one of the tags the steps will not run, if HIT_TAGS is set the steps only run if it
+// contains one of the tags. Steps without tags always run.
+//
+// Tag without steps tags the whole test, it will be skipped like with Skip() if the tag is filtered.
+//
+// Examples:
+//     MustDo(
+//         Tag("slow"),
+//         Get("https://example.com/report"),
+//     )
+//
+//     MustDo(
+//         Get("https://example.com/users"),
+//         Tag("slow",
+//             Expect().Body().JSON().Len("", 10000),
+//         ),
+//     )
+func Tag(tag string, steps ...IStep) IStep {
+	return labelGroup(newClearPath("Tag", []interface{}{tag}), "", []string{tag}, steps)
+}
@@ -0,0 +1,168 @@
+package hit_test
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+// setEnv sets the environment variable and returns a function that restores the previous value
+func setEnv(t *testing.T, name, value string) func() {
+	previous, ok := os.LookupEnv(name)
+	require.NoError(t, os.Setenv(name, value))
+	return func() {
+		if ok {
+			_ = os.Setenv(name, previous)
+			return
+		}
+		_ = os.Unsetenv(name)
+	}
+}
+
+func TestName(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("error", func(t *testing.T) {
+		err := Do(
+			Description("users"),
+			Post(s.URL),
+			Send().Body("Hello World"),
+			Name("checks body",
+				Expect().Body().Equal("Hello Earth"),
+			),
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "users - checks body")
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		err := Do(
+			Post(s.URL),
+			Name("outer",
+				Name("inner",
+					Expect().Status(http.StatusNotFound),
+				),
+			),
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "outer - inner")
+	})
+
+	t.Run("description is restored", func(t *testing.T) {
+		Test(t,
+			Description("users"),
+			Post(s.URL),
+			Name("checks status",
+				Expect().Status(http.StatusOK),
+			),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, "users", hit.Description())
+			}),
+		)
+	})
+
+	t.Run("Debug", func(t *testing.T) {
+		var buf bytes.Buffer
+		Test(t,
+			Post(s.URL),
+			Stdout(&buf),
+			Name("debugging",
+				Debug("Description"),
+			),
+		)
+		require.Contains(t, buf.String(), `"debugging"`)
+	})
+
+	t.Run("StepName and StepTags", func(t *testing.T) {
+		var names []string
+		var tags [][]string
+		Test(t,
+			Post(s.URL),
+			Name("status",
+				Tag("fast",
+					Expect().Status(http.StatusOK),
+				),
+			),
+			OnStep(func(hit Hit, step IStep, when StepTime, err error) {
+				if when == ExpectStep {
+					names = append(names, StepName(step))
+					tags = append(tags, StepTags(step))
+				}
+			}),
+		)
+		require.Equal(t, []string{"status"}, names)
+		require.Equal(t, [][]string{{"fast"}}, tags)
+	})
+}
+
+func TestTag(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	failing := Expect().Status(http.StatusNotFound)
+
+	t.Run("no filter", func(t *testing.T) {
+		require.Error(t, Do(
+			Post(s.URL),
+			Tag("slow", failing),
+		))
+	})
+
+	t.Run("HIT_SKIP_TAGS", func(t *testing.T) {
+		defer setEnv(t, "HIT_SKIP_TAGS", "destructive, slow")()
+		Test(t,
+			Post(s.URL),
+			Tag("slow", failing),
+			Tag("other", Expect().Status(http.StatusOK)),
+		)
+		// nested tags
+		Test(t,
+			Post(s.URL),
+			Tag("fast", Tag("slow", failing)),
+		)
+		require.Error(t, Do(
+			Post(s.URL),
+			Tag("fast", failing),
+		))
+	})
+
+	t.Run("HIT_TAGS", func(t *testing.T) {
+		defer setEnv(t, "HIT_TAGS", "smoke")()
+		Test(t,
+			Post(s.URL),
+			Tag("slow", failing),
+			Expect().Status(http.StatusOK),
+		)
+		require.Error(t, Do(
+			Post(s.URL),
+			Tag("smoke", failing),
+		))
+		require.Error(t, Do(
+			Post(s.URL),
+			Tag("slow", Tag("smoke", failing)),
+		))
+	})
+
+	t.Run("whole test", func(t *testing.T) {
+		defer setEnv(t, "HIT_SKIP_TAGS", "slow")()
+		err := Do(
+			Tag("slow"),
+			Post(s.URL),
+			failing,
+		)
+		require.EqualError(t, err, "skipped: tagged slow")
+
+		var r skipRecorder
+		Test(&r,
+			Tag("slow"),
+			Post(s.URL),
+			failing,
+		)
+		require.Equal(t, []interface{}{"skipped: tagged slow"}, r.skipped)
+	})
+}
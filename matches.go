@@ -0,0 +1,90 @@
+package hit
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/Eun/go-hit/internal/minitest"
+	"golang.org/x/xerrors"
+)
+
+// compileRegexp returns the pattern as a *regexp.Regexp, the pattern can either be a string or a *regexp.Regexp
+func compileRegexp(pattern interface{}) (*regexp.Regexp, error) {
+	switch p := pattern.(type) {
+	case *regexp.Regexp:
+		if p == nil {
+			return nil, xerrors.New("pattern must not be nil")
+		}
+		return p, nil
+	case string:
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, xerrors.Errorf("unable to compile pattern %q: %w", p, err)
+		}
+		return re, nil
+	}
+	return nil, xerrors.Errorf("pattern must be a string or a *regexp.Regexp, got %s", minitest.PrintValue(pattern))
+}
+
+// patternArgument returns the pattern in a form that can be used in a clearPath,
+// a *regexp.Regexp cannot be compared so its source is used
+func patternArgument(pattern interface{}) interface{} {
+	if re, ok := pattern.(*regexp.Regexp); ok && re != nil {
+		return re.String()
+	}
+	return pattern
+}
+
+// patternArguments is patternArgument for variadic clear functions
+func patternArguments(values []interface{}) []interface{} {
+	if len(values) == 0 {
+		return values
+	}
+	args := make([]interface{}, len(values))
+	copy(args, values)
+	args[len(args)-1] = patternArgument(args[len(args)-1])
+	return args
+}
+
+// expectMatch expects the value to match the pattern,
+// the named capture groups of the pattern are stored as variables in hit.
+func expectMatch(hit Hit, pattern interface{}, value string) error {
+	re, err := compileRegexp(pattern)
+	if err != nil {
+		return err
+	}
+	match := re.FindStringSubmatch(value)
+	if match == nil {
+		minitest.Errorf("%s does not match %s", minitest.PrintValue(value), re.String())
+	}
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			hit.SetVar(name, match[i])
+		}
+	}
+	return nil
+}
+
+// expectNoMatch expects the value to not match the pattern
+func expectNoMatch(pattern interface{}, value string) error {
+	re, err := compileRegexp(pattern)
+	if err != nil {
+		return err
+	}
+	if re.MatchString(value) {
+		minitest.Errorf("%s should not match %s", minitest.PrintValue(value), re.String())
+	}
+	return nil
+}
+
+// matchableString returns the value as a string, non string values are encoded as json
+func matchableString(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
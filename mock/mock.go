@@ -0,0 +1,331 @@
+// Package mock provides a programmable http server that can be used to stub services the system under test depends on.
+//
+// Requests are matched with the same steps that are used to test responses with hit:
+//     server := mock.NewServer()
+//     defer server.Close()
+//
+//     users := server.On(
+//         Post("/users"),
+//         Expect().Body().JSON().Equal("Name", "Joe"),
+//     ).Reply(
+//         mock.Status(http.StatusCreated),
+//         mock.Body(map[string]interface{}{"ID": 10, "Name": "Joe"}),
+//     )
+//
+//     // run the system under test against server.URL
+//
+//     server.AssertCalled(t, users)
+//     server.AssertNoUnmatched(t)
+package mock
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/Eun/go-hit"
+	"github.com/Eun/go-hit/internal"
+	"github.com/lunixbochs/vtclean"
+	"golang.org/x/xerrors"
+)
+
+// Server is a local http server that replies with the stubs that were defined with On()
+type Server struct {
+	*httptest.Server
+	mu        sync.Mutex
+	stubs     []*Stub
+	calls     []*Stub
+	unmatched []UnmatchedRequest
+}
+
+// Stub is a request matcher with its replies, use Server.On() to create one
+type Stub struct {
+	server   *Server
+	steps    []hit.IStep
+	replies  []Reply
+	calls    int
+	location string
+}
+
+// TestingT is the part of *testing.T that is used by the assertions of the Server
+type TestingT interface {
+	Fatalf(format string, args ...interface{})
+}
+
+// UnmatchedRequest describes a request that did not match any stub
+type UnmatchedRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+	// Reasons contains the failure of each stub (in definition order)
+	Reasons []error
+}
+
+// NewServer starts and returns a new Server, the caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	srv := &Server{}
+	srv.Server = httptest.NewServer(http.HandlerFunc(srv.serveHTTP))
+	return srv
+}
+
+// On defines a new stub, the stub matches if all specified steps succeed for the incoming request.
+//
+// Method steps (e.g. Get(), Post(), ...) match the method and the path (and query if specified)
+// of the incoming request, Expect() steps run against the incoming request as if it was a response.
+//
+// Example:
+//     server.On(
+//         Post("/users"),
+//         Expect().Header("Content-Type").Equal("application/json"),
+//         Expect().Body().JSON().Equal("Name", "Joe"),
+//     ).Reply(
+//         mock.Status(http.StatusCreated),
+//     )
+func (srv *Server) On(steps ...hit.IStep) *Stub {
+	stub := &Stub{
+		server: srv,
+		steps:  steps,
+	}
+	if _, file, line, ok := runtime.Caller(1); ok {
+		stub.location = fmt.Sprintf("%s:%d", file, line)
+	}
+	srv.mu.Lock()
+	srv.stubs = append(srv.stubs, stub)
+	srv.mu.Unlock()
+	return stub
+}
+
+// Reply sets the replies that will be written if the stub matches
+func (stub *Stub) Reply(replies ...Reply) *Stub {
+	stub.server.mu.Lock()
+	stub.replies = replies
+	stub.server.mu.Unlock()
+	return stub
+}
+
+// String returns the location where the stub was defined
+func (stub *Stub) String() string {
+	return "stub defined at " + stub.location
+}
+
+// Calls returns how often the stub was matched
+func (stub *Stub) Calls() int {
+	stub.server.mu.Lock()
+	defer stub.server.mu.Unlock()
+	return stub.calls
+}
+
+// Unmatched returns all requests that did not match any stub
+func (srv *Server) Unmatched() []UnmatchedRequest {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	unmatched := make([]UnmatchedRequest, len(srv.unmatched))
+	copy(unmatched, srv.unmatched)
+	return unmatched
+}
+
+// Reset removes all stubs and forgets all calls and unmatched requests
+func (srv *Server) Reset() {
+	srv.mu.Lock()
+	srv.stubs = nil
+	srv.calls = nil
+	srv.unmatched = nil
+	srv.mu.Unlock()
+}
+
+// AssertCalled fails the test if one of the specified stubs was not called (in any order)
+func (srv *Server) AssertCalled(t TestingT, stubs ...*Stub) {
+	helper(t)
+	for _, stub := range stubs {
+		if stub.Calls() == 0 {
+			t.Fatalf("%s was not called", stub.String())
+			return
+		}
+	}
+}
+
+// AssertCalledTimes fails the test if the stub was not called exactly n times
+func (srv *Server) AssertCalledTimes(t TestingT, stub *Stub, n int) {
+	helper(t)
+	if calls := stub.Calls(); calls != n {
+		t.Fatalf("%s was called %d time(s), expected %d time(s)", stub.String(), calls, n)
+	}
+}
+
+// AssertNotCalled fails the test if one of the specified stubs was called
+func (srv *Server) AssertNotCalled(t TestingT, stubs ...*Stub) {
+	helper(t)
+	for _, stub := range stubs {
+		if calls := stub.Calls(); calls != 0 {
+			t.Fatalf("%s was called %d time(s), expected no calls", stub.String(), calls)
+			return
+		}
+	}
+}
+
+// AssertCalledInOrder fails the test if the specified stubs were not called in the specified order,
+// other calls in between are allowed
+func (srv *Server) AssertCalledInOrder(t TestingT, stubs ...*Stub) {
+	helper(t)
+	srv.mu.Lock()
+	i := 0
+	for _, call := range srv.calls {
+		if i < len(stubs) && call == stubs[i] {
+			i++
+		}
+	}
+	srv.mu.Unlock()
+	if i < len(stubs) {
+		t.Fatalf("%s was not called in order", stubs[i].String())
+	}
+}
+
+// AssertNoUnmatched fails the test if there were requests that did not match any stub
+func (srv *Server) AssertNoUnmatched(t TestingT) {
+	helper(t)
+	unmatched := srv.Unmatched()
+	if len(unmatched) == 0 {
+		return
+	}
+	var sb strings.Builder
+	for i := range unmatched {
+		sb.WriteString(unmatched[i].String())
+	}
+	t.Fatalf("%d request(s) did not match any stub:\n%s", len(unmatched), sb.String())
+}
+
+// String returns a report of the request and the reasons why no stub matched
+func (req UnmatchedRequest) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s\n", req.Method, req.URL)
+	for i, reason := range req.Reasons {
+		fmt.Fprintf(&sb, "\tstub #%d: %s\n", i+1, strings.ReplaceAll(strings.TrimSpace(vtclean.Clean(reason.Error(), false)), "\n", "\n\t\t"))
+	}
+	return sb.String()
+}
+
+// helper marks the calling assertion as test helper, so failures point to the caller
+func helper(t TestingT) {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+}
+
+func (srv *Server) serveHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the stubs run user code (steps and replies), so the lock is not held while matching or replying
+	srv.mu.Lock()
+	stubs := make([]*Stub, len(srv.stubs))
+	copy(stubs, srv.stubs)
+	srv.mu.Unlock()
+
+	reasons := make([]error, 0, len(stubs))
+	for _, stub := range stubs {
+		if err := match(stub, request, body); err != nil {
+			reasons = append(reasons, err)
+			continue
+		}
+		srv.mu.Lock()
+		stub.calls++
+		srv.calls = append(srv.calls, stub)
+		replies := stub.replies
+		srv.mu.Unlock()
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+		writeReplies(writer, request, replies)
+		return
+	}
+
+	unmatched := UnmatchedRequest{
+		Method:  request.Method,
+		URL:     request.URL.String(),
+		Header:  request.Header,
+		Body:    body,
+		Reasons: reasons,
+	}
+	srv.mu.Lock()
+	srv.unmatched = append(srv.unmatched, unmatched)
+	srv.mu.Unlock()
+	http.Error(writer, unmatched.String(), http.StatusNotFound)
+}
+
+// match runs the steps of the stub, the incoming request is presented to the steps as response
+func match(stub *Stub, request *http.Request, body []byte) error {
+	incoming, err := http.NewRequest(request.Method, request.URL.String(), nil)
+	if err != nil {
+		return err
+	}
+	incoming.Header = request.Header
+
+	client := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if err := matchRequest(req, request); err != nil {
+				return nil, err
+			}
+			return &http.Response{
+				Status:        http.StatusText(http.StatusOK),
+				StatusCode:    http.StatusOK,
+				Proto:         request.Proto,
+				ProtoMajor:    request.ProtoMajor,
+				ProtoMinor:    request.ProtoMinor,
+				Header:        request.Header,
+				Body:          ioutil.NopCloser(bytes.NewReader(body)),
+				ContentLength: int64(len(body)),
+				Request:       req,
+			}, nil
+		}),
+	}
+
+	// the Defaults() of the user describe the requests of the tests, not the incoming requests, so they are not used
+	steps := make([]interface{}, 0, len(stub.steps)+2)
+	steps = append(steps, hit.Request(incoming))
+	for _, step := range stub.steps {
+		steps = append(steps, step)
+	}
+	steps = append(steps, hit.HTTPClient(client))
+	return internal.DoWithoutDefaults(steps)
+}
+
+// matchRequest checks if the request built by the steps matches the incoming request
+func matchRequest(req, incoming *http.Request) error {
+	if req.Method != incoming.Method {
+		return xerrors.Errorf("method %s does not match %s", incoming.Method, req.Method)
+	}
+	if req.URL.Path != "" && req.URL.Path != incoming.URL.Path {
+		return xerrors.Errorf("path %s does not match %s", incoming.URL.Path, req.URL.Path)
+	}
+	query := incoming.URL.Query()
+	for key, values := range req.URL.Query() {
+		for _, value := range values {
+			if !containsString(query[key], value) {
+				return xerrors.Errorf("query %s=%s is missing", key, value)
+			}
+		}
+	}
+	return nil
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
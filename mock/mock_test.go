@@ -0,0 +1,292 @@
+package mock_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/Eun/go-hit/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingT struct {
+	failed  bool
+	message string
+}
+
+func (t *recordingT) Fatalf(format string, args ...interface{}) {
+	t.failed = true
+	t.message = fmt.Sprintf(format, args...)
+}
+
+func TestServer_On(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	users := server.On(
+		Post("/users"),
+		Expect().Header("Content-Type").Equal("application/json"),
+		Expect().Body().JSON().Equal("Name", "Joe"),
+	).Reply(
+		mock.Status(http.StatusCreated),
+		mock.Body(map[string]interface{}{"ID": 10, "Name": "Joe"}),
+	)
+
+	Test(t,
+		Post("%s/users", server.URL),
+		Send().Header("Content-Type", "application/json"),
+		Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+		Expect().Status(http.StatusCreated),
+		Expect().Header("Content-Type").Equal("application/json"),
+		Expect().Body().JSON().Equal("ID", 10),
+	)
+
+	require.Equal(t, 1, users.Calls())
+	server.AssertCalled(t, users)
+	server.AssertCalledTimes(t, users, 1)
+	server.AssertNoUnmatched(t)
+}
+
+func TestServer_Unmatched(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	users := server.On(
+		Post("/users"),
+		Expect().Body().JSON().Equal("Name", "Joe"),
+	).Reply(
+		mock.Status(http.StatusCreated),
+	)
+
+	t.Run("wrong body", func(t *testing.T) {
+		Test(t,
+			Post("%s/users", server.URL),
+			Send().Body().JSON(map[string]interface{}{"Name": "Alice"}),
+			Expect().Status(http.StatusNotFound),
+			Expect().Body().Contains("POST /users"),
+			Expect().Body().Contains("Not equal"),
+		)
+	})
+
+	t.Run("wrong method", func(t *testing.T) {
+		Test(t,
+			Get("%s/users", server.URL),
+			Expect().Status(http.StatusNotFound),
+			Expect().Body().Contains("method GET does not match POST"),
+		)
+	})
+
+	t.Run("wrong path", func(t *testing.T) {
+		Test(t,
+			Post("%s/groups", server.URL),
+			Expect().Status(http.StatusNotFound),
+			Expect().Body().Contains("path /groups does not match /users"),
+		)
+	})
+
+	server.AssertNotCalled(t, users)
+
+	unmatched := server.Unmatched()
+	require.Len(t, unmatched, 3)
+	require.Equal(t, http.MethodPost, unmatched[0].Method)
+	require.Equal(t, "/users", unmatched[0].URL)
+	require.Equal(t, `{"Name":"Alice"}`, string(unmatched[0].Body))
+	require.Len(t, unmatched[0].Reasons, 1)
+
+	var rt recordingT
+	server.AssertNoUnmatched(&rt)
+	require.True(t, rt.failed)
+	require.Contains(t, rt.message, "3 request(s) did not match any stub")
+}
+
+func TestServer_Query(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	server.On(Get("/search?q=joe")).Reply(mock.Body("found"))
+
+	Test(t,
+		Get("%s/search?q=joe&page=2", server.URL),
+		Expect().Status(http.StatusOK),
+		Expect().Body("found"),
+	)
+
+	Test(t,
+		Get("%s/search?q=alice", server.URL),
+		Expect().Status(http.StatusNotFound),
+		Expect().Body().Contains("query q=joe is missing"),
+	)
+}
+
+func TestServer_FirstMatchWins(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	joe := server.On(
+		Post("/users"),
+		Expect().Body().JSON().Equal("Name", "Joe"),
+	).Reply(mock.Status(http.StatusConflict))
+
+	fallback := server.On(
+		Post("/users"),
+	).Reply(mock.Status(http.StatusCreated))
+
+	Test(t,
+		Post("%s/users", server.URL),
+		Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+		Expect().Status(http.StatusConflict),
+	)
+	Test(t,
+		Post("%s/users", server.URL),
+		Send().Body().JSON(map[string]interface{}{"Name": "Alice"}),
+		Expect().Status(http.StatusCreated),
+	)
+
+	require.Equal(t, 1, joe.Calls())
+	require.Equal(t, 1, fallback.Calls())
+}
+
+func TestServer_AssertCalledInOrder(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	login := server.On(Post("/login"))
+	profile := server.On(Get("/profile"))
+	logout := server.On(Post("/logout"))
+
+	Test(t, Post("%s/login", server.URL), Expect().Status(http.StatusOK))
+	Test(t, Get("%s/profile", server.URL), Expect().Status(http.StatusOK))
+	Test(t, Get("%s/profile", server.URL), Expect().Status(http.StatusOK))
+	Test(t, Post("%s/logout", server.URL), Expect().Status(http.StatusOK))
+
+	server.AssertCalled(t, logout, login)
+	server.AssertCalledInOrder(t, login, profile, logout)
+	server.AssertCalledTimes(t, profile, 2)
+
+	var rt recordingT
+	server.AssertCalledInOrder(&rt, logout, login)
+	require.True(t, rt.failed)
+
+	rt.failed = false
+	server.AssertCalledTimes(&rt, login, 2)
+	require.True(t, rt.failed)
+
+	rt.failed = false
+	server.AssertNotCalled(&rt, login)
+	require.True(t, rt.failed)
+}
+
+func TestServer_Reset(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	stub := server.On(Get("/"))
+	Test(t, Get(server.URL), Expect().Status(http.StatusOK))
+
+	server.Reset()
+	server.AssertNotCalled(t, server.On(Get("/")))
+	Test(t, Post(server.URL), Expect().Status(http.StatusNotFound))
+	require.Len(t, server.Unmatched(), 1)
+
+	var rt recordingT
+	server.AssertCalled(&rt, server.On(Delete("/")))
+	require.True(t, rt.failed)
+	require.Equal(t, 1, stub.Calls())
+}
+
+func TestReplies(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	server.On(Get("/text")).Reply(
+		mock.Header("X-Count", 3),
+		mock.Body("Hello World"),
+	)
+	server.On(Get("/json")).Reply(
+		mock.JSON([]int{1, 2, 3}),
+	)
+	server.On(Get("/func")).Reply(
+		mock.Func(func(writer http.ResponseWriter, request *http.Request) {
+			http.Redirect(writer, request, "/text", http.StatusFound)
+		}),
+	)
+
+	Test(t,
+		Get("%s/text", server.URL),
+		Expect().Header("X-Count").Equal(3),
+		Expect().Body("Hello World"),
+	)
+
+	Test(t,
+		Get("%s/json", server.URL),
+		Expect().Header("Content-Type").Equal("application/json"),
+		Expect().Body().JSON([]int{1, 2, 3}),
+	)
+
+	Test(t,
+		Get("%s/func", server.URL),
+		Expect().Body("Hello World"),
+	)
+}
+
+func TestReplies_Custom(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	server.On(Get("/")).Reply(
+		func(res *mock.Response) error {
+			res.Status = http.StatusAccepted
+			res.Body = []byte("Hello World")
+			return nil
+		},
+	)
+
+	Test(t,
+		Get(server.URL),
+		Expect().Status().Equal(http.StatusAccepted),
+		Expect().Body("Hello World"),
+	)
+}
+
+func TestServer_CallsInHandler(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	var counter *mock.Stub
+	counter = server.On(Get("/")).Reply(
+		mock.Func(func(writer http.ResponseWriter, request *http.Request) {
+			_, _ = fmt.Fprintf(writer, "%d", counter.Calls())
+		}),
+	)
+
+	Test(t, Get(server.URL), Expect().Body("1"))
+	Test(t, Get(server.URL), Expect().Body("2"))
+
+	// replies can be changed while the server is running
+	counter.Reply(mock.Body("done"))
+	Test(t, Get(server.URL), Expect().Body("done"))
+}
+
+func TestServer_Defaults(t *testing.T) {
+	server := mock.NewServer()
+	defer server.Close()
+
+	hookCalls := 0
+	Defaults(
+		BaseURL(server.URL),
+		OnBeforeSend(func(hit Hit) {
+			hookCalls++
+		}),
+		Expect().Status().Equal(http.StatusCreated),
+	)
+	defer Defaults()
+
+	users := server.On(Post("/users")).Reply(mock.Status(http.StatusCreated))
+
+	Test(t, Post("/users"))
+	server.AssertCalled(t, users)
+	server.AssertNoUnmatched(t)
+	// the hook only ran for the request of the test, not for the matching of the stub
+	require.Equal(t, 1, hookCalls)
+}
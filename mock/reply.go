@@ -0,0 +1,122 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// Response is the response that will be written for a matched stub, it is modified by the replies of the stub
+type Response struct {
+	// Status is the status code, it defaults to http.StatusOK
+	Status int
+	// Header contains the response headers
+	Header http.Header
+	// Body is the response body
+	Body []byte
+	// Handler writes the response instead of Status, Header and Body if it is set
+	Handler http.HandlerFunc
+}
+
+// Reply modifies the response of a matched stub
+type Reply func(res *Response) error
+
+// Status sets the status code of the response
+//
+// Example:
+//     server.On(Get("/")).Reply(mock.Status(http.StatusNoContent))
+func Status(code int) Reply {
+	return func(res *Response) error {
+		res.Status = code
+		return nil
+	}
+}
+
+// Header sets the specified response header to the specified value
+//
+// Example:
+//     server.On(Get("/")).Reply(mock.Header("Content-Type", "text/plain"))
+func Header(name string, value interface{}) Reply {
+	return func(res *Response) error {
+		res.Header.Set(name, fmt.Sprint(value))
+		return nil
+	}
+}
+
+// Body sets the response body to the specified value.
+// Strings, byte slices and readers are written as they are, every other value will be encoded as json
+//
+// Examples:
+//     server.On(Get("/")).Reply(mock.Body("Hello World"))
+//     server.On(Get("/")).Reply(mock.Body(map[string]interface{}{"Name": "Joe"}))
+func Body(value interface{}) Reply {
+	return func(res *Response) error {
+		switch v := value.(type) {
+		case string:
+			res.Body = []byte(v)
+		case []byte:
+			res.Body = v
+		case io.Reader:
+			buf, err := ioutil.ReadAll(v)
+			if err != nil {
+				return err
+			}
+			res.Body = buf
+		default:
+			return JSON(value)(res)
+		}
+		return nil
+	}
+}
+
+// JSON sets the response body to the specified json value and sets the Content-Type header
+//
+// Example:
+//     server.On(Get("/")).Reply(mock.JSON([]int{1, 2, 3}))
+func JSON(value interface{}) Reply {
+	return func(res *Response) error {
+		buf, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if res.Header.Get("Content-Type") == "" {
+			res.Header.Set("Content-Type", "application/json")
+		}
+		res.Body = buf
+		return nil
+	}
+}
+
+// Func can be used to write a custom reply
+//
+// Example:
+//     server.On(Get("/")).Reply(mock.Func(func(writer http.ResponseWriter, request *http.Request) {
+//         http.Redirect(writer, request, "/login", http.StatusFound)
+//     }))
+func Func(fn http.HandlerFunc) Reply {
+	return func(res *Response) error {
+		res.Handler = fn
+		return nil
+	}
+}
+
+func writeReplies(writer http.ResponseWriter, request *http.Request, replies []Reply) {
+	res := Response{
+		Status: http.StatusOK,
+		Header: writer.Header(),
+	}
+	for _, reply := range replies {
+		if err := reply(&res); err != nil {
+			http.Error(writer, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	if res.Handler != nil {
+		res.Handler(writer, request)
+		return
+	}
+	writer.WriteHeader(res.Status)
+	_, _ = writer.Write(res.Body)
+}
@@ -0,0 +1,59 @@
+package hit
+
+import (
+	"golang.org/x/xerrors"
+)
+
+// defaultMaxRedirects is the amount of redirects http.Client follows if there is no CheckRedirect function
+const defaultMaxRedirects = 10
+
+// FollowRedirects sets whether redirects should be followed, by default they are followed.
+//
+// If redirects are not followed the redirect response itself is the response of the request.
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com/admin"),
+//         FollowRedirects(false),
+//         Expect().Status(http.StatusFound),
+//         Expect().Redirect().To("/login"),
+//     )
+func FollowRedirects(follow bool) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      BeforeSendStep,
+		ClearPath: newClearPath("FollowRedirects", []interface{}{follow}),
+		Exec: func(hit Hit) error {
+			instance, ok := hit.(*defaultInstance)
+			if !ok {
+				return xerrors.New("FollowRedirects() can only be used with the default hit instance")
+			}
+			instance.noFollowRedirects = !follow
+			return nil
+		},
+	}
+}
+
+// MaxRedirects sets the maximum amount of redirects that will be followed, the request fails if there are more
+// redirects.
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com"),
+//         MaxRedirects(2),
+//     )
+func MaxRedirects(n int) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      BeforeSendStep,
+		ClearPath: newClearPath("MaxRedirects", []interface{}{n}),
+		Exec: func(hit Hit) error {
+			instance, ok := hit.(*defaultInstance)
+			if !ok {
+				return xerrors.New("MaxRedirects() can only be used with the default hit instance")
+			}
+			instance.maxRedirects = &n
+			return nil
+		},
+	}
+}
@@ -60,6 +60,58 @@ type ISend interface {
 	//     )
 	Header(name string, value interface{}) IStep
 
+	// GraphQL sets the request body to a GraphQL request with the specified query, variables and optional operation
+	// name, the Content-Type header is set to application/json.
+	//
+	// Usage:
+	//     Send().GraphQL("{ users { name } }", nil)
+	//     Send().GraphQL("query User($id: ID!) { user(id: $id) { name } }", map[string]interface{}{"id": 1})
+	//     Send().GraphQL("query A { a } query B { b }", nil, "B")
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/graphql"),
+	//         Send().GraphQL("query User($id: ID!) { user(id: $id) { name } }", map[string]interface{}{"id": 1}),
+	//         Expect().GraphQL().Data("user.name").Equal("Joe"),
+	//     )
+	GraphQL(query string, variables interface{}, operationName ...string) IStep
+
+	// JSONRPC sets the request body to a JSON-RPC 2.0 call of the specified method with the specified params, use nil
+	// to omit the params. The ids are assigned automatically, starting with 1.
+	// If you use JSONRPC() more than once the calls are sent as a batch request.
+	//
+	// Usage:
+	//     Send().JSONRPC("user.get", map[string]interface{}{"id": 1})
+	//     Send().JSONRPC("ping", nil)
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com/rpc"),
+	//         Send().JSONRPC("user.get", map[string]interface{}{"id": 1}),
+	//         Send().JSONRPC("user.get", map[string]interface{}{"id": 2}),
+	//         Expect().JSONRPC(0).Result("name").Equal("Joe"),
+	//         Expect().JSONRPC(1).Result("name").Equal("Alice"),
+	//     )
+	JSONRPC(method string, params interface{}) IStep
+
+	// Message sends a message on the websocket connection that was established by WebSocket().
+	//
+	// Strings are sent as text messages, []byte as binary messages and everything else as json text messages.
+	// If you omit the argument you can fine tune the message.
+	//
+	// Usage:
+	//     Send().Message("Hello World")
+	//     Send().Message().JSON(map[string]interface{}{"Name": "Joe"})
+	//     Send().Message().Close(1000, "bye")
+	//
+	// Example:
+	//     MustDo(
+	//         WebSocket("ws://example.com/chat"),
+	//         Send().Message("Hello World"),
+	//         Expect().Message().Equal("Hello Joe"),
+	//     )
+	Message(value ...interface{}) ISendMessage
+
 	// Custom can be used to send a custom behaviour.
 	//
 	// Example:
@@ -152,6 +204,63 @@ func (snd *send) Header(name string, value interface{}) IStep {
 	}
 }
 
+func (snd *send) GraphQL(query string, variables interface{}, operationName ...string) IStep {
+	args := []interface{}{query, variables}
+	for i := range operationName {
+		args = append(args, operationName[i])
+	}
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      SendStep,
+		ClearPath: snd.clearPath().Push("GraphQL", args),
+		Exec: func(hit Hit) error {
+			envelope := map[string]interface{}{
+				"query": query,
+			}
+			if variables != nil {
+				envelope["variables"] = variables
+			}
+			if len(operationName) > 0 && operationName[0] != "" {
+				envelope["operationName"] = operationName[0]
+			}
+			hit.Request().Body().JSON().Set(envelope)
+			hit.Request().Header.Set("Content-Type", "application/json")
+			return nil
+		},
+	}
+}
+
+func (snd *send) JSONRPC(method string, params interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      SendStep,
+		ClearPath: snd.clearPath().Push("JSONRPC", []interface{}{method, params}),
+		Exec: func(hit Hit) error {
+			req := hit.Request()
+			call := map[string]interface{}{
+				"jsonrpc": "2.0",
+				"method":  method,
+				"id":      len(req.jsonRPCCalls) + 1,
+			}
+			if params != nil {
+				call["params"] = params
+			}
+			req.jsonRPCCalls = append(req.jsonRPCCalls, call)
+			if len(req.jsonRPCCalls) == 1 {
+				req.Body().JSON().Set(call)
+			} else {
+				req.Body().JSON().Set(req.jsonRPCCalls)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			return nil
+		},
+	}
+}
+
+func (snd *send) Message(value ...interface{}) ISendMessage {
+	return newSendMessage(snd.clearPath().Push("Message", value), value)
+}
+
 func (snd *send) Custom(fn Callback) IStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
@@ -187,6 +296,21 @@ func (snd *finalSend) Body(...interface{}) ISendBody {
 	}
 }
 
+func (snd *finalSend) GraphQL(string, interface{}, ...string) IStep {
+	return snd.fail()
+}
+
+func (snd *finalSend) JSONRPC(string, interface{}) IStep {
+	return snd.fail()
+}
+
+func (snd *finalSend) Message(...interface{}) ISendMessage {
+	return &finalSendMessage{
+		snd.fail(),
+		snd.message,
+	}
+}
+
 func (snd *finalSend) Custom(Callback) IStep {
 	return snd.fail()
 }
@@ -1,6 +1,9 @@
 package hit
 
 import (
+	"bytes"
+	"compress/gzip"
+
 	"github.com/Eun/go-hit/errortrace"
 	"github.com/Eun/go-hit/internal"
 	"golang.org/x/xerrors"
@@ -19,6 +22,49 @@ type ISendBody interface {
 	//         Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
 	//     )
 	JSON(value interface{}) IStep
+
+	// XML sets the request body to the specified xml value.
+	//
+	// Usage:
+	//     Send().Body().XML(User{Name: "Joe"})
+	//
+	// Example:
+	//     MustDo(
+	//         Get("https://example.com"),
+	//         Send().Body().XML(User{Name: "Joe"}),
+	//     )
+	XML(value interface{}) IStep
+
+	// MsgPack sets the request body to the MessagePack encoding of the specified value and sets the Content-Type
+	// header to application/msgpack.
+	//
+	// Usage:
+	//     Send().Body().MsgPack(map[string]interface{}{"Name": "Joe"})
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Send().Body().MsgPack(map[string]interface{}{"Name": "Joe"}),
+	//     )
+	MsgPack(value interface{}) IStep
+
+	// Protobuf sets the request body to the protobuf encoding of the specified message and sets the Content-Type
+	// header to application/x-protobuf.
+	//
+	// go-hit does not ship a protobuf implementation, the step fails until a codec for ProtobufContentType is registered
+	// with RegisterCodec, e.g. RegisterCodec(ProtobufContentType, CodecFuncs(marshal, unmarshal)) with functions that
+	// call proto.Marshal and proto.Unmarshal.
+	//
+	// Usage:
+	//     Send().Body().Protobuf(&pb.User{Name: "Joe"})
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Send().Body().Protobuf(&pb.User{Name: "Joe"}),
+	//     )
+	Protobuf(message interface{}) IStep
+
 	// Interface sets the request body to the specified json value.
 	//
 	// Usage:
@@ -31,6 +77,21 @@ type ISendBody interface {
 	//         Send().Body().Interface("Hello World"),
 	//     )
 	Interface(value interface{}) IStep
+
+	// Gzip compresses the request body with gzip and sets the Content-Encoding header.
+	//
+	// The body is compressed after all other Send() steps ran, so the position of this step does not matter.
+	//
+	// Usage:
+	//     Send().Body().Gzip()
+	//
+	// Example:
+	//     MustDo(
+	//         Post("https://example.com"),
+	//         Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+	//         Send().Body().Gzip(),
+	//     )
+	Gzip() IStep
 }
 
 type sendBody struct {
@@ -83,6 +144,40 @@ func (body *sendBody) JSON(value interface{}) IStep {
 	}
 }
 
+func (body *sendBody) XML(value interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      SendStep,
+		ClearPath: body.clearPath().Push("XML", []interface{}{value}),
+		Exec: func(hit Hit) error {
+			hit.Request().Body().XML().Set(value)
+			return nil
+		},
+	}
+}
+
+func (body *sendBody) MsgPack(value interface{}) IStep {
+	return body.codec("MsgPack", MsgPackContentType, value)
+}
+
+func (body *sendBody) Protobuf(message interface{}) IStep {
+	return body.codec("Protobuf", ProtobufContentType, message)
+}
+
+// codec returns a step that encodes the value with the codec for the content type
+func (body *sendBody) codec(name, contentType string, value interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      SendStep,
+		ClearPath: body.clearPath().Push(name, []interface{}{value}),
+		Exec: func(hit Hit) error {
+			hit.Request().Body().Codec(contentType).Set(value)
+			hit.Request().Header.Set("Content-Type", contentType)
+			return nil
+		},
+	}
+}
+
 func (body *sendBody) Interface(value interface{}) IStep {
 	switch x := value.(type) {
 	case func(e Hit):
@@ -126,6 +221,27 @@ func (body *sendBody) Interface(value interface{}) IStep {
 	}
 }
 
+func (body *sendBody) Gzip() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      AfterSendStep,
+		ClearPath: body.clearPath().Push("Gzip", nil),
+		Exec: func(hit Hit) error {
+			var buf bytes.Buffer
+			w := gzip.NewWriter(&buf)
+			if _, err := w.Write(hit.Request().Body().RawBytes()); err != nil {
+				return err
+			}
+			if err := w.Close(); err != nil {
+				return err
+			}
+			hit.Request().Body().SetBytes(buf.Bytes())
+			hit.Request().Header.Add("Content-Encoding", "gzip")
+			return nil
+		},
+	}
+}
+
 type finalSendBody struct {
 	IStep
 	message string
@@ -146,6 +262,22 @@ func (body *finalSendBody) JSON(interface{}) IStep {
 	return body.fail()
 }
 
+func (body *finalSendBody) XML(interface{}) IStep {
+	return body.fail()
+}
+
+func (body *finalSendBody) MsgPack(interface{}) IStep {
+	return body.fail()
+}
+
+func (body *finalSendBody) Protobuf(interface{}) IStep {
+	return body.fail()
+}
+
 func (body *finalSendBody) Interface(interface{}) IStep {
 	return body.fail()
 }
+
+func (body *finalSendBody) Gzip() IStep {
+	return body.fail()
+}
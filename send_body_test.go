@@ -122,3 +122,21 @@ func TestSendBody_WithoutArgument(t *testing.T) {
 		PtrStr("unable to run Send().Body() without an argument or without a chain. Please use Send().Body(something) or Send().Body().Something"),
 	)
 }
+
+func TestSendBody_Gzip(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Test(t,
+		Post(s.URL),
+		Send().Body().Gzip(),
+		Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+		Send().Header("Accept-Encoding", "gzip"),
+		Expect().Header("Content-Encoding").Equal("gzip"),
+		Expect().Body().JSON().Equal("Name", "Joe"),
+		Expect().Custom(func(hit Hit) {
+			require.Equal(t, `{"Name":"Joe"}`, hit.Request().Body().String())
+			require.NotEqual(t, `{"Name":"Joe"}`, string(hit.Request().Body().RawBytes()))
+		}),
+	)
+}
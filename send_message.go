@@ -0,0 +1,172 @@
+package hit
+
+import (
+	"encoding/json"
+
+	"github.com/Eun/go-hit/errortrace"
+	"github.com/Eun/go-hit/internal"
+	"golang.org/x/xerrors"
+)
+
+// ISendMessage sends messages on the websocket connection that was established by WebSocket().
+//
+// The messages are sent in the ExpectStep, so they run in order with the Expect().Message() steps.
+type ISendMessage interface {
+	IStep
+	// Interface sends the specified value, strings are sent as text messages, []byte as binary messages and
+	// everything else as json text messages.
+	//
+	// Usage:
+	//     Send().Message().Interface("Hello World")
+	//     Send().Message().Interface(map[string]interface{}{"Name": "Joe"})
+	Interface(value interface{}) IStep
+
+	// Text sends a text message.
+	//
+	// Usage:
+	//     Send().Message().Text("Hello World")
+	Text(s string) IStep
+
+	// Binary sends a binary message.
+	//
+	// Usage:
+	//     Send().Message().Binary([]byte{0x01, 0x02})
+	Binary(data []byte) IStep
+
+	// JSON sends the specified value as a json text message.
+	//
+	// Usage:
+	//     Send().Message().JSON(map[string]interface{}{"Name": "Joe"})
+	JSON(value interface{}) IStep
+
+	// Close sends a close message with the specified code and reason.
+	//
+	// Usage:
+	//     Send().Message().Close(1000, "bye")
+	Close(code int, reason string) IStep
+}
+
+type sendMessage struct {
+	cleanPath clearPath
+	trace     *errortrace.ErrorTrace
+}
+
+func newSendMessage(cleanPath clearPath, params []interface{}) ISendMessage {
+	msg := &sendMessage{
+		cleanPath: cleanPath,
+		trace:     ett.Prepare(),
+	}
+
+	if param, ok := internal.GetLastArgument(params); ok {
+		// default action is Interface()
+		return &finalSendMessage{
+			&hitStep{
+				Trace:     msg.trace,
+				When:      ExpectStep,
+				ClearPath: cleanPath,
+				Exec:      msg.Interface(param).exec,
+			},
+			"only usable with Send().Message() not with Send().Message(value)",
+		}
+	}
+	return msg
+}
+
+func (*sendMessage) when() StepTime {
+	return ExpectStep
+}
+
+func (msg *sendMessage) exec(hit Hit) error {
+	return msg.trace.Format(hit.Description(), "unable to run Send().Message() without an argument or without a chain. Please use Send().Message(something) or Send().Message().Something")
+}
+
+func (msg *sendMessage) clearPath() clearPath {
+	return msg.cleanPath
+}
+
+// step returns a step that runs fn with the websocket connection
+func (msg *sendMessage) step(name string, args []interface{}, fn func(ws *HTTPWebSocket) error) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      ExpectStep,
+		ClearPath: msg.clearPath().Push(name, args),
+		Exec: func(hit Hit) error {
+			return fn(hit.Response().WebSocket())
+		},
+	}
+}
+
+func (msg *sendMessage) Interface(value interface{}) IStep {
+	switch v := value.(type) {
+	case string:
+		return msg.Text(v)
+	case []byte:
+		return msg.Binary(v)
+	default:
+		return msg.JSON(v)
+	}
+}
+
+func (msg *sendMessage) Text(s string) IStep {
+	return msg.step("Text", []interface{}{s}, func(ws *HTTPWebSocket) error {
+		return ws.WriteText(s)
+	})
+}
+
+func (msg *sendMessage) Binary(data []byte) IStep {
+	return msg.step("Binary", []interface{}{data}, func(ws *HTTPWebSocket) error {
+		return ws.WriteBinary(data)
+	})
+}
+
+func (msg *sendMessage) JSON(value interface{}) IStep {
+	return msg.step("JSON", []interface{}{value}, func(ws *HTTPWebSocket) error {
+		buf, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		return ws.WriteText(string(buf))
+	})
+}
+
+func (msg *sendMessage) Close(code int, reason string) IStep {
+	return msg.step("Close", []interface{}{code, reason}, func(ws *HTTPWebSocket) error {
+		return ws.WriteClose(code, reason)
+	})
+}
+
+type finalSendMessage struct {
+	IStep
+	message string
+}
+
+func (msg *finalSendMessage) fail() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CleanStep,
+		ClearPath: nil,
+		Exec: func(hit Hit) error {
+			return xerrors.New(msg.message)
+		},
+	}
+}
+
+func (msg *finalSendMessage) Interface(interface{}) IStep {
+	return msg.fail()
+}
+
+func (msg *finalSendMessage) Text(string) IStep {
+	return msg.fail()
+}
+
+func (msg *finalSendMessage) Binary([]byte) IStep {
+	return msg.fail()
+}
+
+func (msg *finalSendMessage) JSON(interface{}) IStep {
+	return msg.fail()
+}
+
+func (msg *finalSendMessage) Close(int, string) IStep {
+	return msg.fail()
+}
@@ -0,0 +1,49 @@
+package hit
+
+import (
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// SoftAssertionsError is returned by Do() if SoftAssertions() was used and one or more steps failed.
+// It contains the errors of all failed steps in the order they occurred.
+type SoftAssertionsError []error
+
+func (e SoftAssertionsError) Error() string {
+	var sb strings.Builder
+	for i, err := range e {
+		if i > 0 {
+			sb.WriteRune('\n')
+		}
+		sb.WriteString(err.Error())
+	}
+	return sb.String()
+}
+
+// SoftAssertions runs all Expect steps even if one of them fails.
+// The failures will be collected and returned as a SoftAssertionsError after all AfterExpect steps ran.
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com"),
+//         SoftAssertions(),
+//         Expect().Status(http.StatusOK),
+//         Expect().Body().JSON().Equal("Name", "Joe"),
+//         Expect().Body().JSON().Equal("Id", 10),
+//     )
+func SoftAssertions() IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      BeforeExpectStep,
+		ClearPath: newClearPath("SoftAssertions", nil),
+		Exec: func(hit Hit) error {
+			instance, ok := hit.(*defaultInstance)
+			if !ok {
+				return xerrors.New("SoftAssertions() can only be used with the default hit instance")
+			}
+			instance.softAssertions = true
+			return nil
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package hit_test
+
+import (
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftAssertions(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	t.Run("collect all errors", func(t *testing.T) {
+		var calledCustom, calledAfterExpect bool
+		err := Do(
+			Post(s.URL),
+			SoftAssertions(),
+			Send().Body().JSON(map[string]interface{}{"ID": 10, "Name": "Joe"}),
+			Expect().Body().JSON().Equal("ID", 11),
+			Expect().Body().JSON().Equal("Name", "Joe"),
+			Expect().Body().JSON().Equal("Name", "Alice"),
+			Expect().Custom(func(hit Hit) {
+				calledCustom = true
+			}),
+			Custom(AfterExpectStep, func(hit Hit) {
+				calledAfterExpect = true
+			}),
+		)
+		require.True(t, calledCustom)
+		require.True(t, calledAfterExpect)
+
+		require.IsType(t, SoftAssertionsError{}, err)
+		errs := err.(SoftAssertionsError)
+		require.Len(t, errs, 2)
+		ExpectError(t, errs[0], PtrStr("Not equal"), nil, nil, nil, nil, nil, nil)
+		ExpectError(t, errs[1], PtrStr("Not equal"), nil, nil, nil, nil, nil, nil)
+		require.Contains(t, err.Error(), `"Alice"`)
+		require.Contains(t, err.Error(), `11`)
+	})
+
+	t.Run("failing after expect step", func(t *testing.T) {
+		err := Do(
+			Post(s.URL),
+			SoftAssertions(),
+			Send().Body("Hello World"),
+			Expect().Body().Equal("Hello Universe"),
+			Custom(AfterExpectStep, func(hit Hit) {
+				panic("after expect")
+			}),
+		)
+		require.IsType(t, SoftAssertionsError{}, err)
+		errs := err.(SoftAssertionsError)
+		require.Len(t, errs, 2)
+		ExpectError(t, errs[1], PtrStr("after expect"))
+	})
+
+	t.Run("no errors", func(t *testing.T) {
+		Test(t,
+			Post(s.URL),
+			SoftAssertions(),
+			Send().Body("Hello World"),
+			Expect().Body().Equal("Hello World"),
+		)
+	})
+
+	t.Run("without soft assertions", func(t *testing.T) {
+		calledCustom := false
+		ExpectError(t,
+			Do(
+				Post(s.URL),
+				Send().Body("Hello World"),
+				Expect().Body().Equal("Hello Universe"),
+				Expect().Custom(func(hit Hit) {
+					calledCustom = true
+				}),
+			),
+			PtrStr("Not equal"), nil, nil, nil, nil, nil, nil,
+		)
+		require.False(t, calledCustom)
+	})
+}
@@ -5,11 +5,14 @@ import (
 	"os"
 
 	"io"
+	"strings"
 
 	"fmt"
 
 	"github.com/Eun/go-hit/errortrace"
 	"github.com/Eun/go-hit/internal"
+	"github.com/Eun/go-hit/internal/websocket"
+	"golang.org/x/xerrors"
 )
 
 //nolint:gochecknoglobals
@@ -22,6 +25,13 @@ func init() {
 		"runtime",
 		errortrace.IgnorePackage(Do),
 	)
+	internal.DoWithoutDefaults = func(steps []interface{}) error {
+		hitSteps := make([]IStep, len(steps))
+		for i := range steps {
+			hitSteps[i] = steps[i].(IStep)
+		}
+		return do(hitSteps)
+	}
 }
 
 // Send sends the specified data as the body payload
@@ -84,7 +94,7 @@ func HTTPClient(client *http.Client) IStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
 		When:      BeforeSendStep,
-		ClearPath: nil, // not clearable
+		ClearPath: newClearPath("HTTPClient", []interface{}{client}),
 		Exec: func(hit Hit) error {
 			hit.SetHTTPClient(client)
 			return nil
@@ -104,7 +114,7 @@ func Stdout(w io.Writer) IStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
 		When:      BeforeSendStep,
-		ClearPath: nil, // not clearable
+		ClearPath: newClearPath("Stdout", []interface{}{w}),
 		Exec: func(hit Hit) error {
 			hit.SetStdout(w)
 			return nil
@@ -126,7 +136,7 @@ func BaseURL(url string, a ...interface{}) IStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
 		When:      BeforeSendStep,
-		ClearPath: nil, // not clearable
+		ClearPath: newClearPath("BaseURL", append([]interface{}{url}, a...)),
 		Exec: func(hit Hit) error {
 			hit.SetBaseURL(url, a...)
 			return nil
@@ -145,7 +155,7 @@ func Request(request *http.Request) IStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
 		When:      BeforeSendStep,
-		ClearPath: nil, // not clearable
+		ClearPath: newClearPath("Request", []interface{}{request}),
 		Exec: func(hit Hit) error {
 			hit.SetRequest(request)
 			return nil
@@ -164,14 +174,32 @@ func Request(request *http.Request) IStep {
 //         Method(http.MethodGet, "https://%s/%s", "example.com", "index.html"),
 //     )
 func Method(method, url string, a ...interface{}) IStep {
-	return makeMethodStep(method, url, a...)
+	return makeMethodStep(newClearPath("Method", append([]interface{}{method, url}, a...)), method, url, a...)
 }
 
-func makeMethodStep(method, url string, a ...interface{}) IStep {
+// methodSteps maps the http methods to the names of their shortcut steps
+//nolint:gochecknoglobals
+var methodSteps = []struct {
+	method string
+	name   string
+}{
+	{http.MethodConnect, "Connect"},
+	{http.MethodDelete, "Delete"},
+	{http.MethodGet, "Get"},
+	{http.MethodHead, "Head"},
+	{http.MethodPost, "Post"},
+	{http.MethodOptions, "Options"},
+	{http.MethodPut, "Put"},
+	{http.MethodTrace, "Trace"},
+}
+
+// makeMethodStep creates the step for Method() and its shortcuts, the clear path is the call the user made
+// (e.g. Post(url)) so it shows up as such in the error messages of Clear()
+func makeMethodStep(cleanPath clearPath, method, url string, a ...interface{}) IStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
 		When:      BeforeSendStep,
-		ClearPath: nil, // not clearable
+		ClearPath: cleanPath,
 		Exec: func(hit Hit) error {
 			request, err := http.NewRequest(method, internal.MakeURL(hit.BaseURL(), url, a...), nil)
 			if err != nil {
@@ -196,7 +224,7 @@ func makeMethodStep(method, url string, a ...interface{}) IStep {
 //         Connect("https://%s/%s", "example.com", "index.html"),
 //     )
 func Connect(url string, a ...interface{}) IStep {
-	return makeMethodStep(http.MethodConnect, url, a...)
+	return makeMethodStep(newClearPath("Connect", append([]interface{}{url}, a...)), http.MethodConnect, url, a...)
 }
 
 // Delete creates a new Hit instance with DELETE as the http makeMethodStep, use the optional arguments to format the url
@@ -211,7 +239,7 @@ func Connect(url string, a ...interface{}) IStep {
 //     )
 //
 func Delete(url string, a ...interface{}) IStep {
-	return makeMethodStep(http.MethodDelete, url, a...)
+	return makeMethodStep(newClearPath("Delete", append([]interface{}{url}, a...)), http.MethodDelete, url, a...)
 }
 
 // Get creates a new Hit instance with GET as the http makeMethodStep, use the optional arguments to format the url
@@ -226,7 +254,7 @@ func Delete(url string, a ...interface{}) IStep {
 //     )
 //
 func Get(url string, a ...interface{}) IStep {
-	return makeMethodStep(http.MethodGet, url, a...)
+	return makeMethodStep(newClearPath("Get", append([]interface{}{url}, a...)), http.MethodGet, url, a...)
 }
 
 // Head creates a new Hit instance with HEAD as the http makeMethodStep, use the optional arguments to format the url
@@ -241,7 +269,7 @@ func Get(url string, a ...interface{}) IStep {
 //     )
 //
 func Head(url string, a ...interface{}) IStep {
-	return makeMethodStep(http.MethodHead, url, a...)
+	return makeMethodStep(newClearPath("Head", append([]interface{}{url}, a...)), http.MethodHead, url, a...)
 }
 
 // Post creates a new Hit instance with POST as the http makeMethodStep, use the optional arguments to format the url
@@ -256,7 +284,7 @@ func Head(url string, a ...interface{}) IStep {
 //     )
 //
 func Post(url string, a ...interface{}) IStep {
-	return makeMethodStep(http.MethodPost, url, a...)
+	return makeMethodStep(newClearPath("Post", append([]interface{}{url}, a...)), http.MethodPost, url, a...)
 }
 
 // Options creates a new Hit instance with OPTIONS as the http makeMethodStep, use the optional arguments to format the url
@@ -271,7 +299,7 @@ func Post(url string, a ...interface{}) IStep {
 //     )
 //
 func Options(url string, a ...interface{}) IStep {
-	return makeMethodStep(http.MethodOptions, url, a...)
+	return makeMethodStep(newClearPath("Options", append([]interface{}{url}, a...)), http.MethodOptions, url, a...)
 }
 
 // Put creates a new Hit instance with PUT as the http makeMethodStep, use the optional arguments to format the url
@@ -286,7 +314,7 @@ func Options(url string, a ...interface{}) IStep {
 //     )
 //
 func Put(url string, a ...interface{}) IStep {
-	return makeMethodStep(http.MethodPut, url, a...)
+	return makeMethodStep(newClearPath("Put", append([]interface{}{url}, a...)), http.MethodPut, url, a...)
 }
 
 // Trace creates a new Hit instance with TRACE as the http makeMethodStep, use the optional arguments to format the url
@@ -301,13 +329,64 @@ func Put(url string, a ...interface{}) IStep {
 //     )
 //
 func Trace(url string, a ...interface{}) IStep {
-	return makeMethodStep(http.MethodTrace, url, a...)
+	return makeMethodStep(newClearPath("Trace", append([]interface{}{url}, a...)), http.MethodTrace, url, a...)
 }
 
-// Test runs the specified steps and calls t.Error() if any error occurs during execution
+// WebSocket creates a new Hit instance that opens a websocket connection to the specified url,
+// use the optional arguments to format the url.
+//
+// The Send().Message() and Expect().Message() steps run in the order they were specified,
+// so they can be used to describe a conversation.
+//
+// Example:
+//     MustDo(
+//         WebSocket("ws://example.com/chat"),
+//         Send().Message("Hello"),
+//         Expect().Message().Equal("Hello Joe"),
+//         Send().Message().JSON(map[string]interface{}{"Command": "Quit"}),
+//         Expect().Message().Closed(1000),
+//     )
+func WebSocket(url string, a ...interface{}) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      BeforeSendStep,
+		ClearPath: newClearPath("WebSocket", append([]interface{}{url}, a...)),
+		Exec: func(hit Hit) error {
+			u := internal.MakeURL(hit.BaseURL(), url, a...)
+			switch {
+			case strings.HasPrefix(u, "ws://"):
+				u = "http://" + strings.TrimPrefix(u, "ws://")
+			case strings.HasPrefix(u, "wss://"):
+				u = "https://" + strings.TrimPrefix(u, "wss://")
+			}
+			request, err := http.NewRequest(http.MethodGet, u, nil)
+			if err != nil {
+				return err
+			}
+			// remove some standard headers
+			request.Header.Set("User-Agent", "")
+			if err := websocket.SetHandshakeHeaders(request.Header); err != nil {
+				return err
+			}
+			hit.SetRequest(request)
+			return nil
+		},
+	}
+}
+
+// Test runs the specified steps and calls t.Error() if any error occurs during execution, if the steps were skipped
+// with Skip() or SkipIf() t.Skip() is called
 func Test(t TestingT, steps ...IStep) {
 	if err := Do(steps...); err != nil {
-		if _, ok := err.(errortrace.ErrorTraceError); !ok {
+		if isSkipped(err) {
+			if s, ok := t.(interface{ Skip(args ...interface{}) }); ok {
+				s.Skip(err.Error())
+			}
+			return
+		}
+		switch err.(type) {
+		case errortrace.ErrorTraceError, SoftAssertionsError:
+		default:
 			os.Stderr.WriteString(ett.Format("", err.Error()).Error())
 			t.FailNow()
 		}
@@ -318,12 +397,33 @@ func Test(t TestingT, steps ...IStep) {
 
 // Do runs the specified steps and returns error if something was wrong
 func Do(steps ...IStep) error {
+	return do(withDefaults(steps))
+}
+
+// do runs the specified steps without adding the Defaults()
+func do(steps []IStep) error {
 	hit := &defaultInstance{
 		client: http.DefaultClient,
 		stdout: os.Stdout,
 		steps:  steps,
 		state:  CombineStep,
 	}
+	defer hit.close()
+	// register the hooks before any step runs, so they see all steps
+	for _, step := range hit.steps {
+		if hook, ok := step.(*hookStep); ok {
+			hit.addHook(hook)
+		}
+	}
+	err := hit.run()
+	if err != nil && !isSkipped(err) {
+		hit.runFailureHooks(err)
+	}
+	return err
+}
+
+// run runs the steps of all phases and sends the request
+func (hit *defaultInstance) run() error {
 	if err := hit.runSteps(CombineStep); err != nil {
 		return err
 	}
@@ -346,13 +446,25 @@ func Do(steps ...IStep) error {
 	if err := hit.runSteps(AfterSendStep); err != nil {
 		return err
 	}
-	hit.request.Request.Body = hit.request.Body().Reader()
-	res, err := hit.client.Do(hit.request.Request)
+	if err := hit.runHooks(hit.beforeSendHooks); err != nil {
+		return err
+	}
+	hit.request.Request.Body = hit.request.Body().RawReader()
+	if hit.dryRun != nil {
+		// do not send the request, hand it to DryRun()
+		return hit.dryRun.exec(hit)
+	}
+	res, redirects, err := hit.doRequest()
 	if err != nil {
 		return fmt.Errorf("unable to perform request: %s", err.Error())
 	}
 
 	hit.response = newHTTPResponse(hit, res)
+	hit.response.redirects = redirects
+	if err := hit.runHooks(hit.responseHooks); err != nil {
+		return err
+	}
+
 	hit.state = BeforeExpectStep
 	if err := hit.runSteps(BeforeExpectStep); err != nil {
 		return err
@@ -363,14 +475,74 @@ func Do(steps ...IStep) error {
 	}
 	hit.state = AfterExpectStep
 	if err := hit.runSteps(AfterExpectStep); err != nil {
+		if len(hit.softErrors) > 0 {
+			return SoftAssertionsError(append(hit.softErrors, err))
+		}
 		return err
 	}
+	if len(hit.softErrors) > 0 {
+		return SoftAssertionsError(hit.softErrors)
+	}
 	if hit.request.Request.Body != nil {
 		if err := hit.request.Request.Body.Close(); err != nil {
 			return err
 		}
 	}
-	return err
+	return nil
+}
+
+// close closes the response and the connections of a transport that was built for this request
+func (hit *defaultInstance) close() {
+	// close the connection when we are done, this also stops reading from streams (e.g. server-sent events)
+	if hit.response != nil {
+		_ = hit.response.close()
+	}
+	hit.transport.close()
+}
+
+// doRequest sends the request with a copy of the client that applies the redirect and transport settings and records
+// the responses of the followed redirects, the client of the instance is not modified
+func (hit *defaultInstance) doRequest() (*http.Response, []*http.Response, error) {
+	var redirects []*http.Response
+	client := *hit.client
+	if hit.request.URL.Scheme == "unix" {
+		socket, err := rewriteUnixURL(hit.request.URL)
+		if err != nil {
+			return nil, nil, err
+		}
+		cfg := hit.transportConfig()
+		cfg.dialNetwork = "unix"
+		cfg.dialAddress = socket
+	}
+	if hit.transport != nil {
+		transport, err := hit.transport.build(client.Transport)
+		if err != nil {
+			return nil, nil, err
+		}
+		client.Transport = transport
+	}
+	checkRedirect := hit.client.CheckRedirect
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if hit.noFollowRedirects {
+			return http.ErrUseLastResponse
+		}
+		switch {
+		case hit.maxRedirects != nil:
+			if len(via) > *hit.maxRedirects {
+				return xerrors.Errorf("stopped after %d redirects", *hit.maxRedirects)
+			}
+		case checkRedirect != nil:
+			if err := checkRedirect(req, via); err != nil {
+				return err
+			}
+		case len(via) >= defaultMaxRedirects:
+			return xerrors.Errorf("stopped after %d redirects", defaultMaxRedirects)
+		}
+		redirects = append(redirects, req.Response)
+		return nil
+	}
+	res, err := client.Do(hit.request.Request)
+	return res, redirects, err
 }
 
 // MustDo runs the specified steps and panics with the error if something was wrong
@@ -394,7 +566,7 @@ func CombineSteps(steps ...IStep) IStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
 		When:      CombineStep,
-		ClearPath: nil, // not clearable
+		ClearPath: newClearPath("CombineSteps", nil),
 		Exec: func(hit Hit) error {
 			hit.InsertSteps(steps...)
 			return nil
@@ -414,7 +586,7 @@ func Description(description string) IStep {
 	return &hitStep{
 		Trace:     ett.Prepare(),
 		When:      BeforeSendStep,
-		ClearPath: nil, // not clearable
+		ClearPath: newClearPath("Description", []interface{}{description}),
 		Exec: func(hit Hit) error {
 			hit.SetDescription(description)
 			return nil
@@ -443,6 +615,42 @@ func Clear() IClear {
 	return newClear()
 }
 
+// Replace removes all previous steps that match the old step and runs the new steps instead, the steps are matched like
+// with Clear(). Use it to change steps of Defaults(), NewSuite() or templates that Clear() does not provide.
+//
+// Without new steps Replace removes the matching steps.
+//
+// Examples:
+//     MustDo(
+//         Get("https://example.com"),
+//         Expect().Status(http.StatusOK),
+//         Replace(Expect().Status(http.StatusOK), Expect().Status(http.StatusCreated)),
+//     )
+//
+//     MustDo(
+//         Get("https://example.com"),
+//         FollowRedirects(false),
+//         Replace(FollowRedirects(false)),
+//     )
+func Replace(old IStep, steps ...IStep) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      CombineStep,
+		ClearPath: nil, // not clearable
+		Exec: func(hit Hit) error {
+			path := old.clearPath()
+			if path == nil {
+				return xerrors.New("unable to replace a step that is not clearable")
+			}
+			if err := removeSteps(hit, path); err != nil {
+				return err
+			}
+			hit.InsertSteps(steps...)
+			return nil
+		},
+	}
+}
+
 // Custom can be used to run custom logic during various steps.
 //
 // Example:
@@ -41,6 +41,11 @@ func (s StepTime) String() string {
 	return ""
 }
 
+// GoString returns the name of the StepTime, it is used when a step is printed e.g. Custom(ExpectStep, ...)
+func (s StepTime) GoString() string {
+	return s.String()
+}
+
 type IStep interface {
 	when() StepTime
 	clearPath() clearPath
@@ -61,6 +66,11 @@ func (step *hitStep) exec(h Hit) (err error) {
 	defer func() {
 		r := recover()
 		if r != nil {
+			// skipping is not a failure, keep the error so Do() can detect it
+			if e, ok := r.(error); ok && isSkipped(e) {
+				err = e
+				return
+			}
 			var ok bool
 			err, ok = r.(errortrace.ErrorTraceError)
 			if !ok {
@@ -69,7 +79,7 @@ func (step *hitStep) exec(h Hit) (err error) {
 		}
 	}()
 	err = step.Exec(h)
-	if err != nil {
+	if err != nil && !isSkipped(err) {
 		if _, ok := err.(errortrace.ErrorTraceError); !ok {
 			err = step.Trace.Format(h.Description(), err.Error())
 		}
@@ -0,0 +1,102 @@
+package hit
+
+import (
+	"sync"
+)
+
+//nolint:gochecknoglobals
+var (
+	defaultStepsMu sync.RWMutex
+	defaultSteps   []IStep
+)
+
+// Defaults sets steps that run before the steps of every Do(), MustDo() and Test() call, calling Defaults() without
+// steps removes the defaults.
+//
+// Defaults can be removed or replaced for a single request with Clear() and Replace().
+//
+// Example:
+//     func TestMain(m *testing.M) {
+//         Defaults(
+//             BaseURL("https://example.com"),
+//             Send().Header("Authorization", "Bearer token"),
+//         )
+//         os.Exit(m.Run())
+//     }
+func Defaults(steps ...IStep) {
+	defaultStepsMu.Lock()
+	defaultSteps = steps
+	defaultStepsMu.Unlock()
+}
+
+// withDefaults returns the default steps followed by the specified steps
+func withDefaults(steps []IStep) []IStep {
+	defaultStepsMu.RLock()
+	defer defaultStepsMu.RUnlock()
+	if len(defaultSteps) == 0 {
+		return steps
+	}
+	return joinSteps(defaultSteps, steps)
+}
+
+// joinSteps returns a new slice with the steps of a followed by the steps of b
+func joinSteps(a, b []IStep) []IStep {
+	steps := make([]IStep, 0, len(a)+len(b))
+	steps = append(steps, a...)
+	return append(steps, b...)
+}
+
+// Suite runs its steps before the steps of every Do(), MustDo() and Test() call of the suite.
+type Suite struct {
+	steps []IStep
+}
+
+// NewSuite creates a suite that runs the specified steps before the steps of every request, the steps run after the
+// Defaults().
+//
+// Suite steps can be removed or replaced for a single request with Clear() and Replace().
+//
+// Example:
+//     s := NewSuite(
+//         BaseURL("https://example.com"),
+//         Send().Header("Authorization", "Bearer token"),
+//     )
+//     s.Test(t,
+//         Get("/users"),
+//         Expect().Status(http.StatusOK),
+//     )
+func NewSuite(steps ...IStep) *Suite {
+	return &Suite{
+		steps: steps,
+	}
+}
+
+// Steps returns the steps of the suite
+func (s *Suite) Steps() []IStep {
+	return s.steps
+}
+
+// With creates a new suite that runs the steps of this suite followed by the specified steps
+//
+// Example:
+//     admin := s.With(
+//         Send().Header("Authorization", "Bearer admin-token"),
+//     )
+func (s *Suite) With(steps ...IStep) *Suite {
+	return NewSuite(joinSteps(s.steps, steps)...)
+}
+
+// Test runs the steps of the suite and the specified steps and calls t.Error() if any error occurs during execution
+func (s *Suite) Test(t TestingT, steps ...IStep) {
+	Test(t, joinSteps(s.steps, steps)...)
+}
+
+// Do runs the steps of the suite and the specified steps and returns error if something was wrong
+func (s *Suite) Do(steps ...IStep) error {
+	return Do(joinSteps(s.steps, steps)...)
+}
+
+// MustDo runs the steps of the suite and the specified steps and panics with the error if something was wrong
+func (s *Suite) MustDo(steps ...IStep) {
+	MustDo(joinSteps(s.steps, steps)...)
+}
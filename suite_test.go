@@ -0,0 +1,126 @@
+package hit_test
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuite(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	suite := NewSuite(
+		BaseURL(s.URL),
+		Send().Header("X-Token", "secret"),
+		Expect().Status(http.StatusOK),
+	)
+
+	t.Run("steps are prepended", func(t *testing.T) {
+		suite.Test(t,
+			Get("/"),
+			Expect().Header("X-Token").Equal("secret"),
+		)
+
+		require.NoError(t, suite.Do(
+			Post("/"),
+			Send().Body("Hello World"),
+			Expect().Body("Hello World"),
+			Expect().Header("X-Token").Equal("secret"),
+		))
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		suite.Test(t,
+			Get("/"),
+			Clear().Send().Header("X-Token"),
+			Expect().Header("X-Token").Empty(),
+		)
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		suite.Test(t,
+			BaseURL(s.URL+"/api"),
+			Get("/"),
+			Send().Header("X-Path", "yes"),
+			Expect().Custom(func(hit Hit) {
+				require.Equal(t, "/api/", hit.Request().URL.Path)
+			}),
+		)
+	})
+
+	t.Run("With", func(t *testing.T) {
+		admin := suite.With(
+			Clear().Send().Header("X-Token"),
+			Send().Header("X-Token", "admin"),
+		)
+		admin.Test(t,
+			Get("/"),
+			Expect().Header("X-Token").Equal("admin"),
+		)
+		require.Len(t, admin.Steps(), 5)
+		require.Len(t, suite.Steps(), 3)
+	})
+
+	t.Run("failing", func(t *testing.T) {
+		ExpectError(t,
+			suite.Do(
+				Get("/"),
+				Clear().Expect().Status(),
+				Expect().Status(http.StatusNotFound),
+			),
+			PtrStr("Expected status code to be 404 but was 200 instead"),
+		)
+
+		require.Panics(t, func() {
+			suite.MustDo(
+				Get("/"),
+				Expect().Header("X-Token").Equal("other"),
+			)
+		})
+	})
+}
+
+func TestDefaults(t *testing.T) {
+	s := EchoServer()
+	defer s.Close()
+
+	Defaults(
+		BaseURL(s.URL),
+		Send().Header("X-Token", "secret"),
+	)
+	defer Defaults()
+
+	Test(t,
+		Get("/"),
+		Expect().Header("X-Token").Equal("secret"),
+	)
+
+	t.Run("Clear", func(t *testing.T) {
+		Test(t,
+			Get("/"),
+			Clear().Send().Header("X-Token"),
+			Expect().Header("X-Token").Empty(),
+		)
+	})
+
+	t.Run("Suite", func(t *testing.T) {
+		// the suite steps run after the defaults
+		NewSuite(
+			Clear().Send().Header(),
+			Send().Header("X-Token", "suite"),
+		).Test(t,
+			Get("/"),
+			Expect().Header("X-Token").Equal("suite"),
+		)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		Defaults()
+		err := Do(Get("/"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported protocol scheme")
+	})
+}
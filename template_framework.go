@@ -1,4 +1,5 @@
-// +build -ignore
+//go:build ignore
+// +build ignore
 
 // You can use this file as an template to build your own framework. Just change / add the functions you need.
 // See also examples/extensibility
@@ -12,18 +13,374 @@ import (
 	"github.com/Eun/go-hit"
 )
 
+// CodecFuncs builds a Codec from a marshal and an unmarshal function.
+//
+// Example:
+//
+//	RegisterCodec(ProtobufContentType, CodecFuncs(
+//	    func(v interface{}) ([]byte, error) {
+//	        m, ok := v.(proto.Message)
+//	        if !ok {
+//	            return nil, fmt.Errorf("%T is not a proto.Message", v)
+//	        }
+//	        return proto.Marshal(m)
+//	    },
+//	    func(data []byte, v interface{}) error {
+//	        m, ok := v.(proto.Message)
+//	        if !ok {
+//	            return fmt.Errorf("%T is not a proto.Message", v)
+//	        }
+//	        return proto.Unmarshal(data, m)
+//	    },
+//	))
+func CodecFuncs(marshal func(v interface{}) ([]byte, error), unmarshal func(data []byte, v interface{}) error) hit.Codec {
+	return hit.CodecFuncs(marshal, unmarshal)
+}
+
+// RegisterCodec registers a codec for the specified Content-Type, parameters of the content type are ignored.
+//
+// json, MessagePack, yaml, xml and application/x-www-form-urlencoded are supported out of the box, vendor types with
+// a structured syntax suffix (e.g. application/vnd.api+json) use the codec of the suffix if there is no codec
+// registered for them.
+// go-hit does not depend on a protobuf implementation so a protobuf codec has to be registered for
+// ProtobufContentType before Send().Body().Protobuf() and Expect().Body().Protobuf() can be used, both fail with
+// "no codec registered for application/x-protobuf" otherwise.
+//
+// Example:
+//
+//	RegisterCodec("application/cbor", CodecFuncs(cbor.Marshal, cbor.Unmarshal))
+func RegisterCodec(contentType string, codec hit.Codec) {
+	hit.RegisterCodec(contentType, codec)
+}
+
+// Skip stops the execution of all steps, Test() marks the test as skipped and Do() returns ErrSkipped.
+// If Skip() is not used in If() or Unless() the request will not be sent.
+//
+// Examples:
+//
+//	Test(t,
+//	    Get("https://example.com"),
+//	    Skip("example.com is not available in the ci"),
+//	)
+//
+//	Test(t,
+//	    Get("https://example.com"),
+//	    Expect().Custom(func(hit Hit) {
+//	        if hit.Response().StatusCode == http.StatusServiceUnavailable {
+//	            hit.MustDo(Skip("example.com is in maintenance"))
+//	        }
+//	    }),
+//	)
+func Skip(reason string) hit.IStep {
+	return hit.Skip(reason)
+}
+
+// SkipIf skips the steps like Skip() if the specified environment variable is set to a non empty value.
+//
+// Example:
+//
+//	Test(t,
+//	    SkipIf("SHORT"),
+//	    Get("https://example.com"),
+//	)
+func SkipIf(envVar string) hit.IStep {
+	return hit.SkipIf(envVar)
+}
+
+// If runs the specified steps only if the condition is true.
+//
+// The condition is evaluated during the earliest phase of the steps, e.g. during the ExpectStep for Expect() steps,
+// so the condition can use the response. Steps that can run during any phase (e.g. Skip() or Clear()) run right after
+// the condition.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    If(func(hit Hit) bool {
+//	        return hit.Response().StatusCode == http.StatusTooManyRequests
+//	    },
+//	        Expect().Header("Retry-After").NotEqual(""),
+//	    ),
+//	)
+func If(condition func(hit hit.Hit) bool, steps ...hit.IStep) hit.IStep {
+	return hit.If(condition, steps...)
+}
+
+// Unless runs the specified steps only if the condition is false, the condition is evaluated like in If().
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    Unless(func(hit Hit) bool {
+//	        return hit.Response().StatusCode == http.StatusNoContent
+//	    },
+//	        Expect().Body().JSON().Equal("Name", "Joe"),
+//	    ),
+//	)
+func Unless(condition func(hit hit.Hit) bool, steps ...hit.IStep) hit.IStep {
+	return hit.Unless(condition, steps...)
+}
+
+// Explain resolves the CombineSteps() and Clear() steps without sending a request and returns the final steps ordered
+// by the StepTime they run in, each with its CallString() and the location it was created at.
+// Use it to see which steps of Defaults(), suites and templates are left for a request.
+//
+// Steps that are added during later phases (e.g. by If() or Custom() steps) are not resolved.
+//
+// Example:
+//
+//	explanation, err := Explain(
+//	    Post("https://example.com"),
+//	    CombineSteps(
+//	        Send().Body("Hello World"),
+//	        Expect().Body().Equal("Hello World"),
+//	    ),
+//	    Clear().Expect(),
+//	)
+//	if err != nil {
+//	    panic(err)
+//	}
+//	fmt.Println(explanation)
+func Explain(steps ...hit.IStep) (string, error) {
+	return hit.Explain(steps...)
+}
+
+// DryRun runs all steps up to the sending of the request (including the OnBeforeSend() hooks) and calls fn with the
+// complete request (url, headers and body) instead of sending it, the Expect() steps do not run.
+//
+// Example:
+//
+//	MustDo(
+//	    Post("https://example.com"),
+//	    Send().Header("Content-Type", "application/json"),
+//	    Send().Body().JSON(map[string]interface{}{"Name": "Joe"}),
+//	    DryRun(func(request *HTTPRequest) {
+//	        fmt.Println(request.URL.String(), request.Body().String())
+//	    }),
+//	)
+func DryRun(fn func(request *hit.HTTPRequest)) hit.IStep {
+	return hit.DryRun(fn)
+}
+
+// OnBeforeSend registers a hook that runs right before the request is sent, after all send steps ran.
+// Use it with Defaults() or NewSuite() to modify every request.
+//
+// Example:
+//
+//	Defaults(
+//	    OnBeforeSend(func(hit Hit) {
+//	        hit.Request().Header.Set("X-Request-Id", uuid.New().String())
+//	    }),
+//	)
+func OnBeforeSend(fn hit.Callback) hit.IStep {
+	return hit.OnBeforeSend(fn)
+}
+
+// OnResponse registers a hook that runs right after the response was received, before the expect steps run.
+//
+// Example:
+//
+//	Defaults(
+//	    OnResponse(func(hit Hit) {
+//	        log.Printf("%s %s: %d", hit.Request().Method, hit.Request().URL, hit.Response().StatusCode)
+//	    }),
+//	)
+func OnResponse(fn hit.Callback) hit.IStep {
+	return hit.OnResponse(fn)
+}
+
+// OnFailure registers a hook that runs if the request failed, err is the error that Do() returns.
+// The response (if there is any) is still readable.
+//
+// Example:
+//
+//	Defaults(
+//	    OnFailure(func(hit Hit, err error) {
+//	        if hit.Response() != nil {
+//	            log.Printf("%s failed: %s", hit.Request().URL, hit.Response().Body().String())
+//	        }
+//	    }),
+//	)
+func OnFailure(fn func(hit hit.Hit, err error)) hit.IStep {
+	return hit.OnFailure(fn)
+}
+
+// OnStep registers a hook that runs after every step, when is the phase the step ran in and err is the error of the
+// step.
+//
+// Example:
+//
+//	Defaults(
+//	    OnStep(func(hit Hit, step IStep, when StepTime, err error) {
+//	        stepCounter.WithLabelValues(when.String()).Inc()
+//	    }),
+//	)
+func OnStep(fn func(hit hit.Hit, step hit.IStep, when hit.StepTime, err error)) hit.IStep {
+	return hit.OnStep(fn)
+}
+
+// RegisterContentDecoder registers a decoder for the specified Content-Encoding, use it to add support for other
+// encodings or to replace a built in decoder.
+//
+// gzip, deflate, br and zstd are supported out of the box.
+//
+// Example:
+//
+//	RegisterContentDecoder("lz4", func(r io.Reader) (io.Reader, error) {
+//	    return lz4.NewReader(r), nil
+//	})
+func RegisterContentDecoder(encoding string, decoder hit.ContentDecoder) {
+	hit.RegisterContentDecoder(encoding, decoder)
+}
+
+// RegisterCharsetDecoder registers a decoder for the specified Content-Type charset.
+//
+// utf-8, us-ascii and iso-8859-1 are supported out of the box.
+//
+// Example:
+//
+//	RegisterCharsetDecoder("windows-1252", func(r io.Reader) (io.Reader, error) {
+//	    return charmap.Windows1252.NewDecoder().Reader(r), nil
+//	})
+func RegisterCharsetDecoder(charset string, decoder hit.CharsetDecoder) {
+	hit.RegisterCharsetDecoder(charset, decoder)
+}
+
+// IgnorePaths ignores the specified paths when comparing json values.
+//
+// The paths are relative to the compared value, use * to match any key or array index. Keys are case sensitive, like
+// the keys of the compared values.
+//
+// Usage:
+//
+//	Expect().Body().JSON().Equal("", expected, IgnorePaths("createdAt", "items.*.id"))
+func IgnorePaths(paths ...string) hit.JSONOption {
+	return hit.IgnorePaths(paths...)
+}
+
+// IgnoreOrder ignores the order of the elements in the arrays at the specified paths when comparing json values.
+//
+// The paths are relative to the compared value, use * to match any key or array index and an empty path for the
+// compared value itself. Keys are case sensitive, like the keys of the compared values.
+//
+// Usage:
+//
+//	Expect().Body().JSON().Equal("", expected, IgnoreOrder("tags", "items.*.roles"))
+func IgnoreOrder(paths ...string) hit.JSONOption {
+	return hit.IgnoreOrder(paths...)
+}
+
+// StepName returns the name of the step that was set with Name(), if the step is part of multiple named groups the
+// names are joined. Use it in OnStep() hooks to report the steps.
+func StepName(step hit.IStep) string {
+	return hit.StepName(step)
+}
+
+// StepTags returns the tags of the step that were set with Tag().
+func StepTags(step hit.IStep) []string {
+	return hit.StepTags(step)
+}
+
+// Name names the specified steps, the name is part of the description in the errors and in the Debug() output of
+// the steps. Names of nested groups are joined.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com/users?page=2"),
+//	    Name("checks pagination",
+//	        Expect().Header("X-Page").Equal(2),
+//	        Expect().Body().JSON().Len("", 10),
+//	    ),
+//	)
+func Name(name string, steps ...hit.IStep) hit.IStep {
+	return hit.Name(name, steps...)
+}
+
+// Tag tags the specified steps, tagged steps can be filtered with the HIT_TAGS and HIT_SKIP_TAGS environment
+// variables (comma separated lists of tags):
+// if HIT_SKIP_TAGS contains one of the tags the steps will not run, if HIT_TAGS is set the steps only run if it
+// contains one of the tags. Steps without tags always run.
+//
+// Tag without steps tags the whole test, it will be skipped like with Skip() if the tag is filtered.
+//
+// Examples:
+//
+//	MustDo(
+//	    Tag("slow"),
+//	    Get("https://example.com/report"),
+//	)
+//
+//	MustDo(
+//	    Get("https://example.com/users"),
+//	    Tag("slow",
+//	        Expect().Body().JSON().Len("", 10000),
+//	    ),
+//	)
+func Tag(tag string, steps ...hit.IStep) hit.IStep {
+	return hit.Tag(tag, steps...)
+}
+
+// FollowRedirects sets whether redirects should be followed, by default they are followed.
+//
+// If redirects are not followed the redirect response itself is the response of the request.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com/admin"),
+//	    FollowRedirects(false),
+//	    Expect().Status(http.StatusFound),
+//	    Expect().Redirect().To("/login"),
+//	)
+func FollowRedirects(follow bool) hit.IStep {
+	return hit.FollowRedirects(follow)
+}
+
+// MaxRedirects sets the maximum amount of redirects that will be followed, the request fails if there are more
+// redirects.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    MaxRedirects(2),
+//	)
+func MaxRedirects(n int) hit.IStep {
+	return hit.MaxRedirects(n)
+}
+
+// SoftAssertions runs all Expect steps even if one of them fails.
+// The failures will be collected and returned as a SoftAssertionsError after all AfterExpect steps ran.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    SoftAssertions(),
+//	    Expect().Status(http.StatusOK),
+//	    Expect().Body().JSON().Equal("Name", "Joe"),
+//	    Expect().Body().JSON().Equal("Id", 10),
+//	)
+func SoftAssertions() hit.IStep {
+	return hit.SoftAssertions()
+}
+
 // Send sends the specified data as the body payload
 //
 // Examples:
-//     MustDo(
-//         Post("https://example.com"),
-//         Send("Hello World"),
-//     )
-//
-//     MustDo(
-//         Post("https://example.com"),
-//         Send().Body("Hello World")
-//     )
+//
+//	MustDo(
+//	    Post("https://example.com"),
+//	    Send("Hello World"),
+//	)
+//
+//	MustDo(
+//	    Post("https://example.com"),
+//	    Send().Body("Hello World")
+//	)
 func Send(data ...interface{}) hit.ISend {
 	return hit.Send(data...)
 }
@@ -31,15 +388,16 @@ func Send(data ...interface{}) hit.ISend {
 // Expect expects the body to be equal the specified value, omit the parameter to get more options
 //
 // Examples:
-//     MustDo(
-//         Get("https://example.com"),
-//         Expect().Body().Contains("Hello World")
-//     )
-//
-//     MustDo(
-//         Get("https://example.com"),
-//         Expect("Hello World"),
-//     )
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    Expect().Body().Contains("Hello World")
+//	)
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    Expect("Hello World"),
+//	)
 func Expect(data ...interface{}) hit.IExpect {
 	return hit.Expect(data...)
 }
@@ -47,15 +405,16 @@ func Expect(data ...interface{}) hit.IExpect {
 // Debug prints the current Request and Response to hit.Stdout(), you can filter the output based on expressions
 //
 // Examples:
-//     MustDo(
-//         Get("https://example.com"),
-//         Debug(),
-//     )
-//
-//     MustDo(
-//         Get("https://example.com"),
-//         Debug("Response.Headers"),
-//     )
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    Debug(),
+//	)
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    Debug("Response.Headers"),
+//	)
 func Debug(expression ...string) hit.IStep {
 	return hit.Debug(expression...)
 }
@@ -63,11 +422,12 @@ func Debug(expression ...string) hit.IStep {
 // HTTPClient sets the client for the request
 //
 // Example:
-//     var client http.Client
-//     MustDo(
-//         Get("https://example.com"),
-//         HTTPClient(&client),
-//     )
+//
+//	var client http.Client
+//	MustDo(
+//	    Get("https://example.com"),
+//	    HTTPClient(&client),
+//	)
 func HTTPClient(client *http.Client) hit.IStep {
 	return hit.HTTPClient(client)
 }
@@ -75,11 +435,12 @@ func HTTPClient(client *http.Client) hit.IStep {
 // Stdout sets the output to the specified writer
 //
 // Example:
-//     MustDo(
-//         Get("https://example.com"),
-//         Stdout(os.Stderr),
-//         Debug(),
-//     )
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    Stdout(os.Stderr),
+//	    Debug(),
+//	)
 func Stdout(w io.Writer) hit.IStep {
 	return hit.Stdout(w)
 }
@@ -87,13 +448,14 @@ func Stdout(w io.Writer) hit.IStep {
 // BaseURL sets the base url for each Connect, Delete, Get, Head, Post, Options, Put, Trace or Method
 //
 // Examples:
-//     MustDo(
-//         BaseURL("https://example.com")
-//     )
 //
-//     MustDo(
-//         BaseURL("https://%s/%s", "example.com", "index.html")
-//     )
+//	MustDo(
+//	    BaseURL("https://example.com")
+//	)
+//
+//	MustDo(
+//	    BaseURL("https://%s/%s", "example.com", "index.html")
+//	)
 func BaseURL(url string, a ...interface{}) hit.IStep {
 	return hit.BaseURL(url, a...)
 }
@@ -101,10 +463,11 @@ func BaseURL(url string, a ...interface{}) hit.IStep {
 // Request creates a new Hit instance with an existing http request
 //
 // Example:
-//     request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
-//     MustDo(
-//         Request(request),
-//     )
+//
+//	request, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+//	MustDo(
+//	    Request(request),
+//	)
 func Request(request *http.Request) hit.IStep {
 	return hit.Request(request)
 }
@@ -112,13 +475,14 @@ func Request(request *http.Request) hit.IStep {
 // Method creates a new Hit instance with the specified method and url
 //
 // Examples:
-//     MustDo(
-//         Method(http.MethodGet, "https://example.com"),
-//     )
 //
-//     MustDo(
-//         Method(http.MethodGet, "https://%s/%s", "example.com", "index.html"),
-//     )
+//	MustDo(
+//	    Method(http.MethodGet, "https://example.com"),
+//	)
+//
+//	MustDo(
+//	    Method(http.MethodGet, "https://%s/%s", "example.com", "index.html"),
+//	)
 func Method(method, url string, a ...interface{}) hit.IStep {
 	return hit.Method(method, url, a...)
 }
@@ -126,13 +490,14 @@ func Method(method, url string, a ...interface{}) hit.IStep {
 // Connect creates a new Hit instance with CONNECT as the http makeMethodStep, use the optional arguments to format the url
 //
 // Examples:
-//     MustDo(
-//         Connect("https://example.com"),
-//     )
 //
-//     MustDo(
-//         Connect("https://%s/%s", "example.com", "index.html"),
-//     )
+//	MustDo(
+//	    Connect("https://example.com"),
+//	)
+//
+//	MustDo(
+//	    Connect("https://%s/%s", "example.com", "index.html"),
+//	)
 func Connect(url string, a ...interface{}) hit.IStep {
 	return hit.Connect(url, a...)
 }
@@ -140,14 +505,14 @@ func Connect(url string, a ...interface{}) hit.IStep {
 // Delete creates a new Hit instance with DELETE as the http makeMethodStep, use the optional arguments to format the url
 //
 // Examples:
-//     MustDo(
-//         Delete("https://example.com"),
-//     )
 //
-//     MustDo(
-//         Delete("https://%s/%s", "example.com", "index.html"),
-//     )
+//	MustDo(
+//	    Delete("https://example.com"),
+//	)
 //
+//	MustDo(
+//	    Delete("https://%s/%s", "example.com", "index.html"),
+//	)
 func Delete(url string, a ...interface{}) hit.IStep {
 	return hit.Delete(url, a...)
 }
@@ -155,14 +520,14 @@ func Delete(url string, a ...interface{}) hit.IStep {
 // Get creates a new Hit instance with GET as the http makeMethodStep, use the optional arguments to format the url
 //
 // Examples:
-//     MustDo(
-//         Get("https://example.com"),
-//     )
 //
-//     MustDo(
-//         Get("https://%s/%s", "example.com", "index.html"),
-//     )
+//	MustDo(
+//	    Get("https://example.com"),
+//	)
 //
+//	MustDo(
+//	    Get("https://%s/%s", "example.com", "index.html"),
+//	)
 func Get(url string, a ...interface{}) hit.IStep {
 	return hit.Get(url, a...)
 }
@@ -170,14 +535,14 @@ func Get(url string, a ...interface{}) hit.IStep {
 // Head creates a new Hit instance with HEAD as the http makeMethodStep, use the optional arguments to format the url
 //
 // Examples:
-//     MustDo(
-//         Head("https://example.com"),
-//     )
 //
-//     MustDo(
-//         Head("https://%s/%s", "example.com", "index.html"),
-//     )
+//	MustDo(
+//	    Head("https://example.com"),
+//	)
 //
+//	MustDo(
+//	    Head("https://%s/%s", "example.com", "index.html"),
+//	)
 func Head(url string, a ...interface{}) hit.IStep {
 	return hit.Head(url, a...)
 }
@@ -185,14 +550,14 @@ func Head(url string, a ...interface{}) hit.IStep {
 // Post creates a new Hit instance with POST as the http makeMethodStep, use the optional arguments to format the url
 //
 // Examples:
-//     MustDo(
-//         Post("https://example.com"),
-//     )
 //
-//     MustDo(
-//         Post("https://%s/%s", "example.com", "index.html"),
-//     )
+//	MustDo(
+//	    Post("https://example.com"),
+//	)
 //
+//	MustDo(
+//	    Post("https://%s/%s", "example.com", "index.html"),
+//	)
 func Post(url string, a ...interface{}) hit.IStep {
 	return hit.Post(url, a...)
 }
@@ -200,14 +565,14 @@ func Post(url string, a ...interface{}) hit.IStep {
 // Options creates a new Hit instance with OPTIONS as the http makeMethodStep, use the optional arguments to format the url
 //
 // Examples:
-//     MustDo(
-//         Options("https://example.com"),
-//     )
 //
-//     MustDo(
-//         Options("https://%s/%s", "example.com", "index.html"),
-//     )
+//	MustDo(
+//	    Options("https://example.com"),
+//	)
 //
+//	MustDo(
+//	    Options("https://%s/%s", "example.com", "index.html"),
+//	)
 func Options(url string, a ...interface{}) hit.IStep {
 	return hit.Options(url, a...)
 }
@@ -215,14 +580,14 @@ func Options(url string, a ...interface{}) hit.IStep {
 // Put creates a new Hit instance with PUT as the http makeMethodStep, use the optional arguments to format the url
 //
 // Examples:
-//     MustDo(
-//         Put("https://example.com"),
-//     )
 //
-//     MustDo(
-//         Put("https://%s/%s", "example.com", "index.html"),
-//     )
+//	MustDo(
+//	    Put("https://example.com"),
+//	)
 //
+//	MustDo(
+//	    Put("https://%s/%s", "example.com", "index.html"),
+//	)
 func Put(url string, a ...interface{}) hit.IStep {
 	return hit.Put(url, a...)
 }
@@ -230,19 +595,39 @@ func Put(url string, a ...interface{}) hit.IStep {
 // Trace creates a new Hit instance with TRACE as the http makeMethodStep, use the optional arguments to format the url
 //
 // Examples:
-//     MustDo(
-//         Trace("https://example.com"),
-//     )
 //
-//     MustDo(
-//         Trace("https://%s/%s", "example.com", "index.html"),
-//     )
+//	MustDo(
+//	    Trace("https://example.com"),
+//	)
 //
+//	MustDo(
+//	    Trace("https://%s/%s", "example.com", "index.html"),
+//	)
 func Trace(url string, a ...interface{}) hit.IStep {
 	return hit.Trace(url, a...)
 }
 
-// Test runs the specified steps and calls t.Error() if any error occurs during execution
+// WebSocket creates a new Hit instance that opens a websocket connection to the specified url,
+// use the optional arguments to format the url.
+//
+// The Send().Message() and Expect().Message() steps run in the order they were specified,
+// so they can be used to describe a conversation.
+//
+// Example:
+//
+//	MustDo(
+//	    WebSocket("ws://example.com/chat"),
+//	    Send().Message("Hello"),
+//	    Expect().Message().Equal("Hello Joe"),
+//	    Send().Message().JSON(map[string]interface{}{"Command": "Quit"}),
+//	    Expect().Message().Closed(1000),
+//	)
+func WebSocket(url string, a ...interface{}) hit.IStep {
+	return hit.WebSocket(url, a...)
+}
+
+// Test runs the specified steps and calls t.Error() if any error occurs during execution, if the steps were skipped
+// with Skip() or SkipIf() t.Skip() is called
 func Test(t hit.TestingT, steps ...hit.IStep) {
 	hit.Test(t, steps...)
 }
@@ -260,13 +645,14 @@ func MustDo(steps ...hit.IStep) {
 // CombineSteps combines multiple steps to one
 //
 // Example:
-//     MustDo(
-//         Get("https://example.com"),
-//         CombineSteps(
-//            Expect().Status(http.StatusOK),
-//            Expect().Body("Hello World"),
-//         ),
-//     )
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    CombineSteps(
+//	       Expect().Status(http.StatusOK),
+//	       Expect().Body("Hello World"),
+//	    ),
+//	)
 func CombineSteps(steps ...hit.IStep) hit.IStep {
 	return hit.CombineSteps(steps...)
 }
@@ -275,10 +661,11 @@ func CombineSteps(steps ...hit.IStep) hit.IStep {
 // The description will be printed in an error case
 //
 // Example:
-//     MustDo(
-//         Description("Check if example.com is available"),
-//         Get("https://example.com"),
-//     )
+//
+//	MustDo(
+//	    Description("Check if example.com is available"),
+//	    Get("https://example.com"),
+//	)
 func Description(description string) hit.IStep {
 	return hit.Description(description)
 }
@@ -286,35 +673,223 @@ func Description(description string) hit.IStep {
 // Clear can be used to remove previous steps.
 //
 // Usage:
-//     Clear().Send("Hello World")          // will remove all Send("Hello World") steps
-//     Clear().Send().Body("Hello World")   // will remove all Send().Body("Hello World") steps
-//     Clear().Expect().Body()              // will remove all Expect().Body() steps and all chained steps to Body() e.g. Expect().Body().Equal("Hello World")
-//     Clear().Expect().Body("Hello World") // will remove all Expect().Body("Hello World") steps
-//
-// Example:
-//     MustDo(
-//         Post("https://example.com"),
-//         Expect().Status(http.StatusOK),
-//         Expect().Body().Contains("Welcome to example.com"),
-//         Clear().Expect(),
-//         Expect().Status(http.NotFound),
-//         Expect().Body().Contains("Not found!"),
-//     )
+//
+//	Clear().Send("Hello World")          // will remove all Send("Hello World") steps
+//	Clear().Send().Body("Hello World")   // will remove all Send().Body("Hello World") steps
+//	Clear().Expect().Body()              // will remove all Expect().Body() steps and all chained steps to Body() e.g. Expect().Body().Equal("Hello World")
+//	Clear().Expect().Body("Hello World") // will remove all Expect().Body("Hello World") steps
+//
+// Example:
+//
+//	MustDo(
+//	    Post("https://example.com"),
+//	    Expect().Status(http.StatusOK),
+//	    Expect().Body().Contains("Welcome to example.com"),
+//	    Clear().Expect(),
+//	    Expect().Status(http.NotFound),
+//	    Expect().Body().Contains("Not found!"),
+//	)
 func Clear() hit.IClear {
 	return hit.Clear()
 }
 
+// Replace removes all previous steps that match the old step and runs the new steps instead, the steps are matched like
+// with Clear(). Use it to change steps of Defaults(), NewSuite() or templates that Clear() does not provide.
+//
+// Without new steps Replace removes the matching steps.
+//
+// Examples:
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    Expect().Status(http.StatusOK),
+//	    Replace(Expect().Status(http.StatusOK), Expect().Status(http.StatusCreated)),
+//	)
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    FollowRedirects(false),
+//	    Replace(FollowRedirects(false)),
+//	)
+func Replace(old hit.IStep, steps ...hit.IStep) hit.IStep {
+	return hit.Replace(old, steps...)
+}
+
 // Custom can be used to run custom logic during various steps.
 //
 // Example:
-//     MustDo(
-//         Post("https://example.com"),
-//         Custom(ExpectStep, func(hit Hit) {
-//             if hit.Response().Body().String() != "Hello Earth" {
-//                 panic("Expected Hello Earth")
-//             }
-//         }),
-//     )
+//
+//	MustDo(
+//	    Post("https://example.com"),
+//	    Custom(ExpectStep, func(hit Hit) {
+//	        if hit.Response().Body().String() != "Hello Earth" {
+//	            panic("Expected Hello Earth")
+//	        }
+//	    }),
+//	)
 func Custom(when hit.StepTime, exec hit.Callback) hit.IStep {
 	return hit.Custom(when, exec)
 }
+
+// Defaults sets steps that run before the steps of every Do(), MustDo() and Test() call, calling Defaults() without
+// steps removes the defaults.
+//
+// Defaults can be removed or replaced for a single request with Clear() and Replace().
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//	    Defaults(
+//	        BaseURL("https://example.com"),
+//	        Send().Header("Authorization", "Bearer token"),
+//	    )
+//	    os.Exit(m.Run())
+//	}
+func Defaults(steps ...hit.IStep) {
+	hit.Defaults(steps...)
+}
+
+// NewSuite creates a suite that runs the specified steps before the steps of every request, the steps run after the
+// Defaults().
+//
+// Suite steps can be removed or replaced for a single request with Clear() and Replace().
+//
+// Example:
+//
+//	s := NewSuite(
+//	    BaseURL("https://example.com"),
+//	    Send().Header("Authorization", "Bearer token"),
+//	)
+//	s.Test(t,
+//	    Get("/users"),
+//	    Expect().Status(http.StatusOK),
+//	)
+func NewSuite(steps ...hit.IStep) *hit.Suite {
+	return hit.NewSuite(steps...)
+}
+
+// TLSClientCert presents the specified client certificate to the server, use it to test services that require mutual
+// tls authentication.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    TLSClientCert("client.crt", "client.key"),
+//	)
+func TLSClientCert(certFile, keyFile string) hit.IStep {
+	return hit.TLSClientCert(certFile, keyFile)
+}
+
+// RootCAs verifies the server certificate with the certificate authorities in the specified pem file instead of the
+// system pool.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    RootCAs("ca.pem"),
+//	)
+func RootCAs(pemFile string) hit.IStep {
+	return hit.RootCAs(pemFile)
+}
+
+// InsecureSkipVerify disables the verification of the server certificate.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://localhost"),
+//	    InsecureSkipVerify(),
+//	)
+func InsecureSkipVerify() hit.IStep {
+	return hit.InsecureSkipVerify()
+}
+
+// ServerName sets the server name that is sent to the server (SNI) and used to verify the server certificate.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://127.0.0.1"),
+//	    ServerName("example.com"),
+//	)
+func ServerName(name string) hit.IStep {
+	return hit.ServerName(name)
+}
+
+// Dial makes all connections of the request to the specified address instead of the host of the url, use it to test
+// services that listen on unix sockets or on a different address.
+//
+// Urls in the form unix:///path/to/socket:/path connect to the socket without the need of Dial().
+//
+// Examples:
+//
+//	MustDo(
+//	    Get("http://localhost/health"),
+//	    Dial("unix", "/var/run/app.sock"),
+//	)
+//
+//	MustDo(
+//	    Get("unix:///var/run/app.sock:/health"),
+//	)
+func Dial(network, address string) hit.IStep {
+	return hit.Dial(network, address)
+}
+
+// Resolve connects to the specified address for all requests to host, the host can contain a port to only resolve
+// this port. If the address has no port the port of the request is used.
+//
+// The url, the Host header and the tls server name keep using the host.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://api.example.com/health"),
+//	    Resolve("api.example.com", "127.0.0.1:8443"),
+//	)
+func Resolve(host, address string) hit.IStep {
+	return hit.Resolve(host, address)
+}
+
+// Proxy sends the request through the specified proxy, hosts that are listed in the NO_PROXY environment variable are
+// requested directly. Use an empty url to disable the proxy of the client (e.g. the proxy of the HTTP_PROXY
+// environment variable).
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    Proxy("http://proxy.example.com:3128"),
+//	)
+func Proxy(proxyURL string) hit.IStep {
+	return hit.Proxy(proxyURL)
+}
+
+// Protocol forces the request to use the specified protocol, the request fails if the server does not support it.
+//
+// HTTP/2 is negotiated over tls, use H2C() to use HTTP/2 without tls.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("https://example.com"),
+//	    Protocol(HTTP2),
+//	    Expect().Proto().Equal("HTTP/2.0"),
+//	)
+func Protocol(protocol hit.HTTPProtocol) hit.IStep {
+	return hit.Protocol(protocol)
+}
+
+// H2C sends the request with HTTP/2 without tls (prior knowledge), https urls keep using HTTP/2 over tls.
+//
+// Example:
+//
+//	MustDo(
+//	    Get("http://localhost:8080"),
+//	    H2C(),
+//	    Expect().Proto().Equal("HTTP/2.0"),
+//	)
+func H2C() hit.IStep {
+	return hit.H2C()
+}
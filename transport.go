@@ -0,0 +1,471 @@
+package hit
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// transportConfig collects the transport settings of the steps,
+// the transport of the client is only replaced if there is a transportConfig
+type transportConfig struct {
+	tlsConfig *tls.Config
+
+	// dialNetwork and dialAddress are set by Dial() and unix:// urls, all connections are made to this address
+	dialNetwork string
+	dialAddress string
+
+	// resolve maps host or host:port to an address, it is set by Resolve()
+	resolve map[string]string
+
+	// proxy is set by Proxy(), a nil proxy disables the proxy
+	proxy    *url.URL
+	proxySet bool
+
+	// protocol is set by Protocol() and H2C(), h2c allows HTTP/2 without tls
+	protocol HTTPProtocol
+	h2c      bool
+
+	// built is the transport that was built for the request
+	built *http.Transport
+}
+
+// transportConfig returns the transport settings of the instance, creates them if needed
+func (hit *defaultInstance) transportConfig() *transportConfig {
+	if hit.transport == nil {
+		hit.transport = &transportConfig{}
+	}
+	return hit.transport
+}
+
+// build returns a copy of the base transport with the settings applied
+func (cfg *transportConfig) build(base http.RoundTripper) (*http.Transport, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	t, ok := base.(*http.Transport)
+	if !ok {
+		return nil, xerrors.Errorf("unable to configure the transport, the client uses a %T", base)
+	}
+	transport := cloneTransport(t)
+	if cfg.tlsConfig != nil {
+		transport.TLSClientConfig = cfg.tlsConfig
+	}
+	if cfg.dialNetwork != "" || len(cfg.resolve) > 0 {
+		transport.DialContext = cfg.dialContext(transport)
+		transport.Dial = nil //nolint:staticcheck
+	}
+	if cfg.proxySet {
+		transport.Proxy = cfg.proxyFunc(noProxyFromEnvironment())
+	}
+	if cfg.protocol != 0 {
+		if err := cfg.applyProtocol(transport); err != nil {
+			return nil, err
+		}
+	}
+	cfg.built = transport
+	return transport, nil
+}
+
+// close closes the connections of the built transport, the transport is only used for one request
+func (cfg *transportConfig) close() {
+	if cfg == nil || cfg.built == nil {
+		return
+	}
+	cfg.built.CloseIdleConnections()
+}
+
+// cloneTransport copies the settings of the transport without its state (idle connections), the protocols of the
+// tls configuration and ForceAttemptHTTP2 are kept so HTTP/2 stays available
+func cloneTransport(t *http.Transport) *http.Transport {
+	return t.Clone()
+}
+
+// dialContext returns a dial function that dials the address of Dial() or the addresses of Resolve() with the dial
+// function of the transport
+func (cfg *transportConfig) dialContext(t *http.Transport) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	dial := t.DialContext
+	if dial == nil {
+		if t.Dial != nil { //nolint:staticcheck
+			dial = func(_ context.Context, network, addr string) (net.Conn, error) {
+				return t.Dial(network, addr) //nolint:staticcheck
+			}
+		} else {
+			dial = (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext
+		}
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if cfg.dialNetwork != "" {
+			return dial(ctx, cfg.dialNetwork, cfg.dialAddress)
+		}
+		return dial(ctx, network, cfg.resolveAddress(addr))
+	}
+}
+
+// resolveAddress returns the address that was specified with Resolve() for the host:port or the host of addr
+func (cfg *transportConfig) resolveAddress(addr string) string {
+	if mapped, ok := cfg.resolve[strings.ToLower(addr)]; ok {
+		return mapped
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	mapped, ok := cfg.resolve[strings.ToLower(host)]
+	if !ok {
+		return addr
+	}
+	// use the port of the request if the mapped address has no port
+	if _, _, err := net.SplitHostPort(mapped); err != nil {
+		return net.JoinHostPort(mapped, port)
+	}
+	return mapped
+}
+
+// proxyFunc returns a proxy function that uses the proxy of Proxy() for all requests that are not excluded by noProxy
+func (cfg *transportConfig) proxyFunc(noProxy string) func(*http.Request) (*url.URL, error) {
+	return func(request *http.Request) (*url.URL, error) {
+		if cfg.proxy == nil || useDirectConnection(request.URL, noProxy) {
+			return nil, nil
+		}
+		return cfg.proxy, nil
+	}
+}
+
+func noProxyFromEnvironment() string {
+	if v := os.Getenv("NO_PROXY"); v != "" {
+		return v
+	}
+	return os.Getenv("no_proxy")
+}
+
+// useDirectConnection reports whether the url should be requested without a proxy, the rules of NO_PROXY are the
+// same as the ones of http.ProxyFromEnvironment:
+// localhost and loopback addresses are never proxied, * disables the proxy, an ip or cidr matches ip addresses,
+// example.com matches example.com and its subdomains, .example.com only the subdomains,
+// an optional port restricts the entry to the port.
+func useDirectConnection(u *url.URL, noProxy string) bool {
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" || u.Scheme == "wss" {
+			port = "443"
+		}
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	if ip != nil && ip.IsLoopback() {
+		return true
+	}
+
+	for _, entry := range strings.Split(strings.ToLower(noProxy), ",") {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == "":
+			continue
+		case entry == "*":
+			return true
+		}
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		entryHost, entryPort := entry, ""
+		if h, p, err := net.SplitHostPort(entry); err == nil {
+			entryHost, entryPort = h, p
+		}
+		if entryPort != "" && entryPort != port {
+			continue
+		}
+		if entryIP := net.ParseIP(entryHost); entryIP != nil {
+			if ip != nil && entryIP.Equal(ip) {
+				return true
+			}
+			continue
+		}
+		if strings.HasPrefix(entryHost, ".") {
+			if strings.HasSuffix(host, entryHost) {
+				return true
+			}
+			continue
+		}
+		if host == entryHost || strings.HasSuffix(host, "."+entryHost) {
+			return true
+		}
+	}
+	return false
+}
+
+// rewriteUnixURL rewrites an url in the form unix:///path/to/socket:/path to http://localhost/path and returns the
+// path of the socket
+func rewriteUnixURL(u *url.URL) (string, error) {
+	p := u.Path
+	if u.Host != "" {
+		p = u.Host + p
+	}
+	i := strings.Index(p, ":")
+	socket, path := p, "/"
+	if i >= 0 {
+		socket, path = p[:i], p[i+1:]
+	}
+	if socket == "" {
+		return "", xerrors.Errorf("%s does not contain a socket path, use unix:///path/to/socket:/path", u.String())
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	u.Scheme = "http"
+	u.Host = "localhost"
+	u.Path = path
+	u.RawPath = ""
+	u.Opaque = ""
+	return socket, nil
+}
+
+// transportStep returns a step that modifies the transport settings of the request
+func transportStep(name string, arguments []interface{}, fn func(instance *defaultInstance, cfg *transportConfig) error) IStep {
+	return &hitStep{
+		Trace:     ett.Prepare(),
+		When:      BeforeSendStep,
+		ClearPath: newClearPath(name, arguments),
+		Exec: func(hit Hit) error {
+			instance, ok := hit.(*defaultInstance)
+			if !ok {
+				return xerrors.Errorf("%s() can only be used with the default hit instance", name)
+			}
+			return fn(instance, instance.transportConfig())
+		},
+	}
+}
+
+// tlsStep returns a step that modifies the tls configuration that is used for the request
+func tlsStep(name string, arguments []interface{}, fn func(cfg *tls.Config) error) IStep {
+	return transportStep(name, arguments, func(instance *defaultInstance, cfg *transportConfig) error {
+		if cfg.tlsConfig == nil {
+			cfg.tlsConfig = &tls.Config{} //nolint:gosec
+			if t, ok := instance.client.Transport.(*http.Transport); ok && t.TLSClientConfig != nil {
+				cfg.tlsConfig = t.TLSClientConfig.Clone()
+			}
+		}
+		return fn(cfg.tlsConfig)
+	})
+}
+
+// TLSClientCert presents the specified client certificate to the server, use it to test services that require mutual
+// tls authentication.
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com"),
+//         TLSClientCert("client.crt", "client.key"),
+//     )
+func TLSClientCert(certFile, keyFile string) IStep {
+	return tlsStep("TLSClientCert", []interface{}{certFile, keyFile}, func(cfg *tls.Config) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return xerrors.Errorf("unable to load client certificate: %w", err)
+		}
+		cfg.Certificates = append(cfg.Certificates, cert)
+		return nil
+	})
+}
+
+// RootCAs verifies the server certificate with the certificate authorities in the specified pem file instead of the
+// system pool.
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com"),
+//         RootCAs("ca.pem"),
+//     )
+func RootCAs(pemFile string) IStep {
+	return tlsStep("RootCAs", []interface{}{pemFile}, func(cfg *tls.Config) error {
+		buf, err := ioutil.ReadFile(pemFile)
+		if err != nil {
+			return xerrors.Errorf("unable to read root certificates: %w", err)
+		}
+		if cfg.RootCAs == nil {
+			cfg.RootCAs = x509.NewCertPool()
+		}
+		if !cfg.RootCAs.AppendCertsFromPEM(buf) {
+			return xerrors.Errorf("no certificates found in %s", pemFile)
+		}
+		return nil
+	})
+}
+
+// InsecureSkipVerify disables the verification of the server certificate.
+//
+// Example:
+//     MustDo(
+//         Get("https://localhost"),
+//         InsecureSkipVerify(),
+//     )
+func InsecureSkipVerify() IStep {
+	return tlsStep("InsecureSkipVerify", nil, func(cfg *tls.Config) error {
+		cfg.InsecureSkipVerify = true
+		return nil
+	})
+}
+
+// ServerName sets the server name that is sent to the server (SNI) and used to verify the server certificate.
+//
+// Example:
+//     MustDo(
+//         Get("https://127.0.0.1"),
+//         ServerName("example.com"),
+//     )
+func ServerName(name string) IStep {
+	return tlsStep("ServerName", []interface{}{name}, func(cfg *tls.Config) error {
+		cfg.ServerName = name
+		return nil
+	})
+}
+
+// Dial makes all connections of the request to the specified address instead of the host of the url, use it to test
+// services that listen on unix sockets or on a different address.
+//
+// Urls in the form unix:///path/to/socket:/path connect to the socket without the need of Dial().
+//
+// Examples:
+//     MustDo(
+//         Get("http://localhost/health"),
+//         Dial("unix", "/var/run/app.sock"),
+//     )
+//
+//     MustDo(
+//         Get("unix:///var/run/app.sock:/health"),
+//     )
+func Dial(network, address string) IStep {
+	return transportStep("Dial", []interface{}{network, address}, func(_ *defaultInstance, cfg *transportConfig) error {
+		cfg.dialNetwork = network
+		cfg.dialAddress = address
+		return nil
+	})
+}
+
+// Resolve connects to the specified address for all requests to host, the host can contain a port to only resolve
+// this port. If the address has no port the port of the request is used.
+//
+// The url, the Host header and the tls server name keep using the host.
+//
+// Example:
+//     MustDo(
+//         Get("https://api.example.com/health"),
+//         Resolve("api.example.com", "127.0.0.1:8443"),
+//     )
+func Resolve(host, address string) IStep {
+	return transportStep("Resolve", []interface{}{host, address}, func(_ *defaultInstance, cfg *transportConfig) error {
+		if cfg.resolve == nil {
+			cfg.resolve = make(map[string]string)
+		}
+		cfg.resolve[strings.ToLower(host)] = address
+		return nil
+	})
+}
+
+// Proxy sends the request through the specified proxy, hosts that are listed in the NO_PROXY environment variable are
+// requested directly. Use an empty url to disable the proxy of the client (e.g. the proxy of the HTTP_PROXY
+// environment variable).
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com"),
+//         Proxy("http://proxy.example.com:3128"),
+//     )
+func Proxy(proxyURL string) IStep {
+	return transportStep("Proxy", []interface{}{proxyURL}, func(_ *defaultInstance, cfg *transportConfig) error {
+		cfg.proxySet = true
+		cfg.proxy = nil
+		if proxyURL == "" {
+			return nil
+		}
+		if !strings.Contains(proxyURL, "://") {
+			proxyURL = "http://" + proxyURL
+		}
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return xerrors.Errorf("invalid proxy url: %w", err)
+		}
+		cfg.proxy = u
+		return nil
+	})
+}
+
+// HTTPProtocol is a http protocol version that can be used with Protocol()
+type HTTPProtocol int
+
+const (
+	// HTTP1 is HTTP/1.1
+	HTTP1 HTTPProtocol = iota + 1
+	// HTTP2 is HTTP/2
+	HTTP2
+)
+
+func (p HTTPProtocol) String() string {
+	switch p {
+	case HTTP1:
+		return "HTTP/1.1"
+	case HTTP2:
+		return "HTTP/2.0"
+	}
+	return fmt.Sprintf("HTTPProtocol(%d)", int(p))
+}
+
+// Protocol forces the request to use the specified protocol, the request fails if the server does not support it.
+//
+// HTTP/2 is negotiated over tls, use H2C() to use HTTP/2 without tls.
+//
+// Example:
+//     MustDo(
+//         Get("https://example.com"),
+//         Protocol(HTTP2),
+//         Expect().Proto().Equal("HTTP/2.0"),
+//     )
+func Protocol(protocol HTTPProtocol) IStep {
+	return transportStep("Protocol", []interface{}{protocol}, func(_ *defaultInstance, cfg *transportConfig) error {
+		switch protocol {
+		case HTTP1:
+			cfg.h2c = false
+		case HTTP2:
+		default:
+			return xerrors.Errorf("unknown protocol %s", protocol)
+		}
+		cfg.protocol = protocol
+		return nil
+	})
+}
+
+// H2C sends the request with HTTP/2 without tls (prior knowledge), https urls keep using HTTP/2 over tls.
+//
+// Example:
+//     MustDo(
+//         Get("http://localhost:8080"),
+//         H2C(),
+//         Expect().Proto().Equal("HTTP/2.0"),
+//     )
+func H2C() IStep {
+	return transportStep("H2C", nil, func(_ *defaultInstance, cfg *transportConfig) error {
+		cfg.protocol = HTTP2
+		cfg.h2c = true
+		return nil
+	})
+}
@@ -0,0 +1,81 @@
+package hit
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestUseDirectConnection(t *testing.T) {
+	tests := []struct {
+		url     string
+		noProxy string
+		want    bool
+	}{
+		{"http://example.com", "", false},
+		{"http://localhost:8080", "", true},
+		{"http://127.0.0.1:8080", "", true},
+		{"http://[::1]:8080", "", true},
+		{"http://example.com", "*", true},
+		{"http://example.com", "example.com", true},
+		{"http://api.example.com", "example.com", true},
+		{"http://example.com", ".example.com", false},
+		{"http://api.example.com", ".example.com", true},
+		{"http://notexample.com", "example.com", false},
+		{"http://example.com", "example.org, example.com", true},
+		{"http://example.com:8080", "example.com:8080", true},
+		{"http://example.com", "example.com:8080", false},
+		{"https://example.com", "example.com:443", true},
+		{"http://10.1.2.3", "10.0.0.0/8", true},
+		{"http://192.168.1.1", "10.0.0.0/8", false},
+		{"http://10.1.2.3", "10.1.2.3", true},
+		{"http://EXAMPLE.com", "example.COM", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url+" "+tt.noProxy, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := useDirectConnection(u, tt.noProxy); got != tt.want {
+				t.Errorf("useDirectConnection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRewriteUnixURL(t *testing.T) {
+	tests := []struct {
+		url        string
+		wantURL    string
+		wantSocket string
+		wantErr    bool
+	}{
+		{"unix:///var/run/app.sock:/health", "http://localhost/health", "/var/run/app.sock", false},
+		{"unix:///var/run/app.sock:/health?verbose=1", "http://localhost/health?verbose=1", "/var/run/app.sock", false},
+		{"unix:///var/run/app.sock", "http://localhost/", "/var/run/app.sock", false},
+		{"unix:///var/run/app.sock:health", "http://localhost/health", "/var/run/app.sock", false},
+		{"unix://relative.sock:/health", "http://localhost/health", "relative.sock", false},
+		{"unix://:/health", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			socket, err := rewriteUnixURL(u)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("rewriteUnixURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if socket != tt.wantSocket {
+				t.Errorf("rewriteUnixURL() socket = %v, want %v", socket, tt.wantSocket)
+			}
+			if u.String() != tt.wantURL {
+				t.Errorf("rewriteUnixURL() url = %v, want %v", u.String(), tt.wantURL)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+//go:build go1.24
+// +build go1.24
+
+package hit
+
+import (
+	"net/http"
+)
+
+// applyProtocol restricts the transport to the protocol of Protocol() and H2C()
+func (cfg *transportConfig) applyProtocol(transport *http.Transport) error {
+	protocols := new(http.Protocols)
+	switch cfg.protocol {
+	case HTTP1:
+		protocols.SetHTTP1(true)
+	case HTTP2:
+		protocols.SetHTTP2(true)
+		protocols.SetUnencryptedHTTP2(cfg.h2c)
+	}
+	transport.Protocols = protocols
+	return nil
+}
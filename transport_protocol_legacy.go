@@ -0,0 +1,22 @@
+//go:build !go1.24
+// +build !go1.24
+
+package hit
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"golang.org/x/xerrors"
+)
+
+// applyProtocol restricts the transport to the protocol of Protocol() and H2C(), HTTP/2 can only be configured with
+// go 1.24 or newer because the transport of older versions does not expose its HTTP/2 support
+func (cfg *transportConfig) applyProtocol(transport *http.Transport) error {
+	if cfg.protocol == HTTP1 {
+		// an empty map disables HTTP/2
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		return nil
+	}
+	return xerrors.Errorf("%s requires go 1.24 or newer", cfg.protocol)
+}
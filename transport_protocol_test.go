@@ -0,0 +1,126 @@
+//go:build go1.24
+// +build go1.24
+
+package hit_test
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+func protoServer() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte(request.Proto))
+	})
+}
+
+func TestProtocol(t *testing.T) {
+	s := httptest.NewUnstartedServer(protoServer())
+	s.EnableHTTP2 = true
+	s.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	s.StartTLS()
+	defer s.Close()
+
+	t.Run("HTTP2", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			InsecureSkipVerify(),
+			Protocol(HTTP2),
+			Expect().Proto().Equal("HTTP/2.0"),
+			Expect().Body("HTTP/2.0"),
+		)
+	})
+
+	t.Run("HTTP1", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			InsecureSkipVerify(),
+			Protocol(HTTP1),
+			Expect().Proto().Equal("HTTP/1.1"),
+			Expect().Body("HTTP/1.1"),
+		)
+	})
+
+	t.Run("tls steps keep HTTP2", func(t *testing.T) {
+		Test(t,
+			Get(s.URL),
+			HTTPClient(s.Client()),
+			Expect().Proto().Equal("HTTP/2.0"),
+		)
+		Test(t,
+			Get(s.URL),
+			HTTPClient(s.Client()),
+			ServerName("example.com"),
+			Expect().Proto().Equal("HTTP/2.0"),
+		)
+		Test(t,
+			Get(s.URL),
+			InsecureSkipVerify(),
+			Expect().Proto().Equal("HTTP/2.0"),
+		)
+		Test(t,
+			Get(s.URL),
+			InsecureSkipVerify(),
+			Proxy(""),
+			Expect().Proto().Equal("HTTP/2.0"),
+		)
+	})
+
+	t.Run("server without HTTP2", func(t *testing.T) {
+		s := httptest.NewUnstartedServer(protoServer())
+		s.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+		s.StartTLS()
+		defer s.Close()
+
+		require.Error(t, Do(
+			Get(s.URL),
+			InsecureSkipVerify(),
+			Protocol(HTTP2),
+		))
+	})
+
+	t.Run("unknown protocol", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				Protocol(HTTPProtocol(3)),
+			),
+			PtrStr("unknown protocol HTTPProtocol(3)"),
+		)
+	})
+}
+
+func TestH2C(t *testing.T) {
+	s := httptest.NewUnstartedServer(protoServer())
+	s.Config.Protocols = new(http.Protocols)
+	s.Config.Protocols.SetHTTP1(true)
+	s.Config.Protocols.SetUnencryptedHTTP2(true)
+	s.Start()
+	defer s.Close()
+
+	Test(t,
+		Get(s.URL),
+		H2C(),
+		Expect().Proto().Equal("HTTP/2.0"),
+		Expect().Body("HTTP/2.0"),
+	)
+
+	Test(t,
+		Get(s.URL),
+		Expect().Proto().Equal("HTTP/1.1"),
+	)
+
+	// Protocol(HTTP1) disables h2c
+	Test(t,
+		Get(s.URL),
+		H2C(),
+		Protocol(HTTP1),
+		Expect().Proto().Equal("HTTP/1.1"),
+	)
+}
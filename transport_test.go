@@ -0,0 +1,161 @@
+package hit_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/stretchr/testify/require"
+)
+
+// hostEchoHandler responds with the host and the request uri of the request
+var hostEchoHandler = http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+	_, _ = writer.Write([]byte(request.Host + " " + request.RequestURI))
+})
+
+func TestUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hit-unix")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "app.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	s := &http.Server{Handler: hostEchoHandler}
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	defer s.Close()
+
+	t.Run("url", func(t *testing.T) {
+		Test(t,
+			Get("unix://"+socket+":/health?verbose=1"),
+			Expect().Status(http.StatusOK),
+			Expect().Body("localhost /health?verbose=1"),
+		)
+	})
+
+	t.Run("BaseURL", func(t *testing.T) {
+		Test(t,
+			BaseURL("unix://"+socket+":/api"),
+			Get("/health"),
+			Expect().Body("localhost /api/health"),
+		)
+	})
+
+	t.Run("Dial", func(t *testing.T) {
+		Test(t,
+			Get("http://app.local/health"),
+			Dial("unix", socket),
+			Expect().Body("app.local /health"),
+		)
+	})
+}
+
+func TestDial(t *testing.T) {
+	s := httptest.NewServer(hostEchoHandler)
+	defer s.Close()
+
+	Test(t,
+		Get("http://api.example.com/x"),
+		Dial("tcp", s.Listener.Addr().String()),
+		Expect().Body("api.example.com /x"),
+	)
+}
+
+func TestResolve(t *testing.T) {
+	s := httptest.NewServer(hostEchoHandler)
+	defer s.Close()
+
+	_, port, err := net.SplitHostPort(s.Listener.Addr().String())
+	require.NoError(t, err)
+
+	t.Run("host", func(t *testing.T) {
+		Test(t,
+			Get("http://api.example.com/x"),
+			Resolve("api.example.com", s.Listener.Addr().String()),
+			Expect().Body("api.example.com /x"),
+		)
+	})
+
+	t.Run("host and port", func(t *testing.T) {
+		Test(t,
+			Get("http://api.example.com:1234/x"),
+			Resolve("api.example.com:1234", s.Listener.Addr().String()),
+			Expect().Body("api.example.com:1234 /x"),
+		)
+	})
+
+	t.Run("address without port", func(t *testing.T) {
+		Test(t,
+			Get("http://api.example.com:"+port+"/x"),
+			Resolve("API.example.com", "127.0.0.1"),
+			Expect().Body("api.example.com:"+port+" /x"),
+		)
+	})
+}
+
+func TestProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("proxied " + request.URL.String()))
+	}))
+	defer proxy.Close()
+
+	s := httptest.NewServer(hostEchoHandler)
+	defer s.Close()
+
+	t.Run("proxy", func(t *testing.T) {
+		Test(t,
+			Get("http://api.example.com/x"),
+			Proxy(proxy.URL),
+			Expect().Body("proxied http://api.example.com/x"),
+		)
+	})
+
+	t.Run("without scheme", func(t *testing.T) {
+		Test(t,
+			Get("http://api.example.com/x"),
+			Proxy(proxy.Listener.Addr().String()),
+			Expect().Body("proxied http://api.example.com/x"),
+		)
+	})
+
+	t.Run("NO_PROXY", func(t *testing.T) {
+		noProxy, ok := os.LookupEnv("NO_PROXY")
+		require.NoError(t, os.Setenv("NO_PROXY", "example.org, .example.com"))
+		defer func() {
+			if ok {
+				_ = os.Setenv("NO_PROXY", noProxy)
+			} else {
+				_ = os.Unsetenv("NO_PROXY")
+			}
+		}()
+
+		Test(t,
+			Get("http://api.example.com/x"),
+			Proxy(proxy.URL),
+			Resolve("api.example.com", s.Listener.Addr().String()),
+			Expect().Body("api.example.com /x"),
+		)
+
+		Test(t,
+			Get("http://example.com/x"),
+			Proxy(proxy.URL),
+			Expect().Body("proxied http://example.com/x"),
+		)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		Test(t,
+			Get("http://api.example.com/x"),
+			Proxy(""),
+			Resolve("api.example.com", s.Listener.Addr().String()),
+			Expect().Body("api.example.com /x"),
+		)
+	})
+}
@@ -0,0 +1,191 @@
+package hit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/Eun/go-hit"
+	"github.com/Eun/go-hit/internal/websocket"
+)
+
+// WebSocketServer echos all text and binary messages,
+// it closes the connection with the code 4000 if it receives "quit" and stays silent if it receives "wait"
+func WebSocketServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		conn, err := websocket.Upgrade(writer, request)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			opcode, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			switch string(data) {
+			case "quit":
+				_ = conn.WriteClose(4000, "bye")
+				_, _, _ = conn.ReadMessage()
+				return
+			case "wait":
+				continue
+			}
+			if err := conn.WriteMessage(opcode, data); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func TestWebSocket(t *testing.T) {
+	s := WebSocketServer()
+	defer s.Close()
+
+	url := strings.Replace(s.URL, "http://", "ws://", 1)
+
+	t.Run("conversation", func(t *testing.T) {
+		Test(t,
+			WebSocket(url),
+			Expect().Status(http.StatusSwitchingProtocols),
+			Expect().Message().Timeout(time.Second),
+			Send().Message("Hello World"),
+			Expect().Message("Hello World"),
+			Send().Message().Text("Hello Joe"),
+			Expect().Message().Contains("Joe"),
+			Send().Message().Binary([]byte{0x01, 0x02}),
+			Expect().Message().Equal("\x01\x02"),
+			Send().Message(map[string]interface{}{"Name": "Joe", "ID": 10}),
+			Expect().Message().JSON().Equal("Name", "Joe"),
+			Send().Message().JSON(map[string]interface{}{"Name": "Alice", "ID": 11}),
+			Expect().Message().JSON().Subset("", map[string]interface{}{"ID": 11}),
+			Send().Message("Hello 42"),
+			Expect().Message().Matches(`^Hello \d+$`),
+			Send().Message("quit"),
+			Expect().Message().Closed(4000),
+		)
+	})
+
+	t.Run("base url", func(t *testing.T) {
+		Test(t,
+			BaseURL(url),
+			WebSocket(""),
+			Send().Message("Hello World"),
+			Expect().Message().Equal("Hello World"),
+		)
+	})
+
+	t.Run("client close", func(t *testing.T) {
+		Test(t,
+			WebSocket(url),
+			Send().Message().Close(1000, "bye"),
+			Expect().Message().Closed(1000),
+		)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				WebSocket(url),
+				Send().Message("Hello World"),
+				Expect().Message().Equal("Hello Joe"),
+			),
+			PtrStr("Not equal"), nil, nil, nil, nil, nil, nil,
+		)
+	})
+
+	t.Run("wrong close code", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				WebSocket(url),
+				Send().Message("quit"),
+				Expect().Message().Closed(1000, 1001),
+			),
+			PtrStr("expected websocket close code to be one of [1000 1001], but was 4000"),
+		)
+	})
+
+	t.Run("expected close", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				WebSocket(url),
+				Send().Message("Hello World"),
+				Expect().Message().Closed(),
+			),
+			PtrStr(`expected websocket connection to be closed, but received message "Hello World"`),
+		)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				WebSocket(url),
+				Send().Message("quit"),
+				Expect().Message().Equal("Hello World"),
+			),
+			PtrStr("websocket closed with code 4000: bye"),
+		)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				WebSocket(url),
+				Expect().Message().Timeout(10*time.Millisecond),
+				Send().Message("wait"),
+				Expect().Message().Equal("Hello World"),
+			),
+			PtrStr("no message received within 10ms"),
+		)
+	})
+
+	t.Run("not a websocket", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				Get(s.URL),
+				Send().Message("Hello World"),
+			),
+			PtrStr("request is not a websocket request, did you use WebSocket()?"),
+		)
+	})
+
+	t.Run("final", func(t *testing.T) {
+		ExpectError(t,
+			Do(
+				WebSocket(url),
+				Expect().Message("Hello World").Contains("Hello"),
+			),
+			PtrStr("only usable with Expect().Message() not with Expect().Message(value)"),
+		)
+		ExpectError(t,
+			Do(
+				WebSocket(url),
+				Send().Message("Hello World").Text("Hello"),
+			),
+			PtrStr("only usable with Send().Message() not with Send().Message(value)"),
+		)
+	})
+}
+
+func TestWebSocket_Handshake(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusForbidden)
+	}))
+	defer s.Close()
+
+	// a rejected handshake can still be inspected
+	Test(t,
+		WebSocket(s.URL),
+		Expect().Status(http.StatusForbidden),
+	)
+
+	ExpectError(t,
+		Do(
+			WebSocket(s.URL),
+			Expect().Message().Equal("Hello World"),
+		),
+		PtrStr("websocket handshake failed: expected status 101, got 403"),
+	)
+}